@@ -0,0 +1,58 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krelinga/go-jsonflex"
+)
+
+type AlternativeTitle Object
+
+func (a AlternativeTitle) ISO3166_1() (string, error) {
+	return jsonflex.GetField(a, "iso_3166_1", jsonflex.AsString())
+}
+
+func (a AlternativeTitle) Title() (string, error) {
+	return jsonflex.GetField(a, "title", jsonflex.AsString())
+}
+
+// Type describes the title, like "working title" or "DVD title".  It is often empty.
+func (a AlternativeTitle) Type() (string, error) {
+	return jsonflex.GetField(a, "type", jsonflex.AsString())
+}
+
+// AlternativeTitles lists a title's other names.  TMDB puts them in "titles" for movies and in "results" for shows;
+// All works for either.
+type AlternativeTitles Object
+
+func (a AlternativeTitles) ID() (int32, error) {
+	return jsonflex.GetField(a, "id", jsonflex.AsInt32())
+}
+
+func (a AlternativeTitles) Titles() ([]AlternativeTitle, error) {
+	return jsonflex.GetField(a, "titles", jsonflex.AsArray(jsonflex.AsObject[AlternativeTitle]()))
+}
+
+func (a AlternativeTitles) Results() ([]AlternativeTitle, error) {
+	return jsonflex.GetField(a, "results", jsonflex.AsArray(jsonflex.AsObject[AlternativeTitle]()))
+}
+
+func (a AlternativeTitles) All() ([]AlternativeTitle, error) {
+	titles, err := a.Titles()
+	if errors.Is(err, ErrFieldNotFound) {
+		return a.Results()
+	}
+	return titles, err
+}
+
+// GetMovieAlternativeTitles returns a movie's other names.  Use WithQueryParam("country", ...) to limit them to one
+// ISO 3166-1 region.
+func GetMovieAlternativeTitles(ctx context.Context, client Client, movieID int32, opts ...RequestOption) (AlternativeTitles, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d/alternative_titles", movieID), opts...)
+}
+
+func GetShowAlternativeTitles(ctx context.Context, client Client, showID int32, opts ...RequestOption) (AlternativeTitles, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/alternative_titles", showID), opts...)
+}
@@ -0,0 +1,75 @@
+package tmdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestGetAlternativeTitles(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/550/alternative_titles": `{"id":550,"titles":[{"iso_3166_1":"FR","title":"Fight Club - Le club de combat","type":""}]}`,
+		"/3/tv/1399/alternative_titles":   `{"id":1399,"results":[{"iso_3166_1":"CN","title":"权力的游戏","type":"Simplified"}]}`,
+		"/3/movie/603": `{"id":603,"title":"The Matrix",
+			"alternative_titles":{"titles":[{"iso_3166_1":"BR","title":"Matrix","type":""}]},
+			"translations":{"translations":[{"iso_3166_1":"BR","iso_639_1":"pt","name":"Português","english_name":"Portuguese","data":{"title":"Matrix","overview":"","tagline":"","homepage":"","runtime":136}}]}}`,
+		"/3/tv/1396": `{"id":1396,"name":"Breaking Bad",
+			"alternative_titles":{"results":[{"iso_3166_1":"MX","title":"Hacerse malo","type":""}]},
+			"translations":{"translations":[{"iso_3166_1":"MX","iso_639_1":"es","name":"Español","english_name":"Spanish","data":{"name":"Breaking Bad: Reacciones Químicas","overview":"","tagline":"","homepage":""}}]}}`,
+	})
+	ctx := context.Background()
+
+	if titles, err := tmdb.GetMovieAlternativeTitles(ctx, client, 550); err != nil {
+		t.Errorf("GetMovieAlternativeTitles: %v", err)
+	} else {
+		checkField(t, "Fight Club - Le club de combat", titles, tmdb.AlternativeTitles.Titles, index(0), tmdb.AlternativeTitle.Title)
+		checkField(t, "FR", titles, tmdb.AlternativeTitles.All, index(0), tmdb.AlternativeTitle.ISO3166_1)
+	}
+	if titles, err := tmdb.GetShowAlternativeTitles(ctx, client, 1399); err != nil {
+		t.Errorf("GetShowAlternativeTitles: %v", err)
+	} else {
+		checkField(t, "权力的游戏", titles, tmdb.AlternativeTitles.Results, index(0), tmdb.AlternativeTitle.Title)
+		checkField(t, "Simplified", titles, tmdb.AlternativeTitles.All, index(0), tmdb.AlternativeTitle.Type)
+	}
+
+	if movie, err := tmdb.GetMovie(ctx, client, 603, tmdb.WithAppendToResponse("alternative_titles", "translations")); err != nil {
+		t.Errorf("GetMovie: %v", err)
+	} else {
+		checkField(t, "Matrix", movie, tmdb.Movie.AlternativeTitles, tmdb.AlternativeTitles.All, index(0), tmdb.AlternativeTitle.Title)
+		checkField(t, int32(136), movie, tmdb.Movie.Translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Runtime)
+	}
+	if show, err := tmdb.GetShow(ctx, client, 1396, tmdb.WithAppendToResponse("alternative_titles", "translations")); err != nil {
+		t.Errorf("GetShow: %v", err)
+	} else {
+		checkField(t, "Hacerse malo", show, tmdb.Show.AlternativeTitles, tmdb.AlternativeTitles.All, index(0), tmdb.AlternativeTitle.Title)
+		checkField(t, "Breaking Bad: Reacciones Químicas", show, tmdb.Show.Translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Name)
+	}
+}
+
+func TestAlternativeTitlesAll(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"movie", `{"id":550,"titles":[{"iso_3166_1":"FR","title":"Le club de combat","type":""}]}`, "Le club de combat"},
+		{"show", `{"id":1399,"results":[{"iso_3166_1":"CN","title":"权力的游戏","type":"Simplified"}]}`, "权力的游戏"},
+	}
+	for _, tt := range tests {
+		var titles tmdb.AlternativeTitles
+		if err := json.Unmarshal([]byte(tt.json), &titles); err != nil {
+			t.Fatalf("%s: Unmarshal: %v", tt.name, err)
+		}
+		checkField(t, tt.want, titles, tmdb.AlternativeTitles.All, index(0), tmdb.AlternativeTitle.Title)
+	}
+
+	if _, err := (tmdb.AlternativeTitles{"id": 550}).All(); !errors.Is(err, tmdb.ErrFieldNotFound) {
+		t.Errorf("expected ErrFieldNotFound without titles or results, got %v", err)
+	}
+	if _, err := (tmdb.AlternativeTitles{"titles": "oops", "results": []any{}}).All(); err == nil || errors.Is(err, tmdb.ErrFieldNotFound) {
+		t.Errorf("expected a malformed titles field to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package tmdb
+
+import (
+	"container/list"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long cached responses are used when neither ClientOptions.CacheTTL nor the response's Cache-Control header says otherwise.
+const DefaultCacheTTL = time.Hour
+
+// CacheEntry is a cached response body, along with the validators needed to revalidate it once it expires.
+type CacheEntry struct {
+	Body         []byte
+	Expires      time.Time
+	ETag         string
+	LastModified string
+}
+
+func (e CacheEntry) fresh(now time.Time) bool {
+	return now.Before(e.Expires)
+}
+
+// Cache stores response bodies for a Client.  Keys are the request URL, including the base URL's scheme, host and path
+// prefix, with a canonical query string that excludes api_key.
+//
+// Implementations may return expired entries; the client decides whether an entry is still usable.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry)
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used entry once it holds more than a fixed number of entries.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List
+	entries    map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry CacheEntry
+}
+
+// NewMemoryCache returns a MemoryCache that holds at most maxEntries entries.  A maxEntries of zero or less means no limit.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    map[string]*list.Element{},
+	}
+}
+
+func (mc *MemoryCache) Get(key string) (CacheEntry, bool) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	elem, ok := mc.entries[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+	mc.order.MoveToFront(elem)
+	return elem.Value.(*memoryCacheItem).entry, true
+}
+
+func (mc *MemoryCache) Set(key string, entry CacheEntry) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	if elem, ok := mc.entries[key]; ok {
+		elem.Value.(*memoryCacheItem).entry = entry
+		mc.order.MoveToFront(elem)
+		return
+	}
+	mc.entries[key] = mc.order.PushFront(&memoryCacheItem{key: key, entry: entry})
+	for mc.maxEntries > 0 && mc.order.Len() > mc.maxEntries {
+		oldest := mc.order.Back()
+		mc.order.Remove(oldest)
+		delete(mc.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+func (mc *MemoryCache) Len() int {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	return mc.order.Len()
+}
+
+// FileCache is a Cache that stores each entry as a file in a directory.
+//
+// Errors reading or writing the directory are treated as cache misses.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache returns a FileCache that stores entries in dir, creating it if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+func (fc *FileCache) path(key string) string {
+	return filepath.Join(fc.dir, sha256Hex([]byte(key))+".json")
+}
+
+type fileCacheRecord struct {
+	Key   string     `json:"key"`
+	Entry CacheEntry `json:"entry"`
+}
+
+func (fc *FileCache) Get(key string) (CacheEntry, bool) {
+	data, err := os.ReadFile(fc.path(key))
+	if err != nil {
+		return CacheEntry{}, false
+	}
+	var record fileCacheRecord
+	if err := json.Unmarshal(data, &record); err != nil || record.Key != key {
+		return CacheEntry{}, false
+	}
+	return record.Entry, true
+}
+
+func (fc *FileCache) Set(key string, entry CacheEntry) {
+	data, err := json.Marshal(fileCacheRecord{Key: key, Entry: entry})
+	if err != nil {
+		return
+	}
+	// Write to a temporary file and rename it so that concurrent readers never see a partial entry.
+	tmp, err := os.CreateTemp(fc.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil || os.Rename(tmp.Name(), fc.path(key)) != nil {
+		os.Remove(tmp.Name())
+	}
+}
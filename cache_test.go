@@ -0,0 +1,230 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestMemoryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := tmdb.NewMemoryCache(2)
+	cache.Set("a", tmdb.CacheEntry{Body: []byte("1")})
+	cache.Set("b", tmdb.CacheEntry{Body: []byte("2")})
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+	cache.Set("c", tmdb.CacheEntry{Body: []byte("3")})
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if entry, ok := cache.Get("a"); !ok || string(entry.Body) != "1" {
+		t.Errorf("expected a=1, got %q, %v", entry.Body, ok)
+	}
+	if entry, ok := cache.Get("c"); !ok || string(entry.Body) != "3" {
+		t.Errorf("expected c=3, got %q, %v", entry.Body, ok)
+	}
+	if got := cache.Len(); got != 2 {
+		t.Errorf("expected 2 entries, got %d", got)
+	}
+}
+
+func TestFileCacheRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := tmdb.NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("NewFileCache: %v", err)
+	}
+	expires := time.Now().Add(time.Hour).Round(0)
+	cache.Set("/3/configuration", tmdb.CacheEntry{Body: []byte(`{"images":{}}`), Expires: expires})
+
+	reopened, err := tmdb.NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("NewFileCache: %v", err)
+	}
+	entry, ok := reopened.Get("/3/configuration")
+	if !ok {
+		t.Fatal("expected entry to be found")
+	}
+	if string(entry.Body) != `{"images":{}}` {
+		t.Errorf("Body: got %q", entry.Body)
+	}
+	if !entry.Expires.Equal(expires) {
+		t.Errorf("Expires: got %v, want %v", entry.Expires, expires)
+	}
+	if _, ok := reopened.Get("/3/genre/movie/list"); ok {
+		t.Error("expected miss for unknown key")
+	}
+}
+
+func newCountingServer(t *testing.T, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	calls := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, calls
+}
+
+func TestClientCache(t *testing.T) {
+	server, calls := newCountingServer(t, `{"id":550,"title":"Fight Club"}`)
+	cache := tmdb.NewMemoryCache(10)
+	newClient := func(apiKey string) tmdb.Client {
+		return tmdb.ClientOptions{APIKey: apiKey, BaseURL: server.URL, Cache: cache}.NewClient()
+	}
+	ctx := context.Background()
+
+	for _, apiKey := range []string{"key-1", "key-1", "key-2"} {
+		movie, err := tmdb.GetMovie(ctx, newClient(apiKey), 550)
+		if err != nil {
+			t.Fatalf("GetMovie: %v", err)
+		}
+		checkField(t, "Fight Club", movie, tmdb.Movie.Title)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 call when only api_key differs, got %d", got)
+	}
+
+	if _, err := tmdb.GetMovie(ctx, newClient("key-1"), 550, tmdb.WithQueryParam("language", "de-DE")); err != nil {
+		t.Fatalf("GetMovie: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected a different query to miss the cache, got %d calls", got)
+	}
+	if _, ok := cache.Get(server.URL + "/3/movie/550?language=de-DE"); !ok {
+		t.Error("expected cache key to include the query string without api_key")
+	}
+}
+
+func TestClientCacheSharedAcrossBaseURLs(t *testing.T) {
+	direct, directCalls := newCountingServer(t, `{"id":550,"title":"Fight Club"}`)
+	proxy, proxyCalls := newCountingServer(t, `{"id":550,"title":"Proxied Fight Club"}`)
+	cache := tmdb.NewMemoryCache(10)
+	ctx := context.Background()
+
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{direct.URL, "Fight Club"},
+		{proxy.URL + "/tmdb", "Proxied Fight Club"},
+		{proxy.URL, "Proxied Fight Club"},
+		{direct.URL, "Fight Club"},
+	}
+	for _, tt := range tests {
+		client := tmdb.ClientOptions{BaseURL: tt.baseURL, Cache: cache}.NewClient()
+		if movie, err := tmdb.GetMovie(ctx, client, 550); err != nil {
+			t.Errorf("GetMovie(%s): %v", tt.baseURL, err)
+		} else {
+			checkField(t, tt.want, movie, tmdb.Movie.Title)
+		}
+	}
+	if got := directCalls.Load(); got != 1 {
+		t.Errorf("expected 1 call to the direct server, got %d", got)
+	}
+	if got := proxyCalls.Load(); got != 2 {
+		t.Errorf("expected 1 call per proxy path prefix, got %d", got)
+	}
+}
+
+func TestClientCacheExpires(t *testing.T) {
+	server, calls := newCountingServer(t, `[{"iso_639_1":"en","english_name":"English","name":"English"}]`)
+	client := tmdb.ClientOptions{BaseURL: server.URL, Cache: tmdb.NewMemoryCache(10), CacheTTL: 50 * time.Millisecond}.NewClient()
+	ctx := context.Background()
+	for range 2 {
+		languages, err := tmdb.GetConfigLanguages(ctx, client)
+		if err != nil {
+			t.Fatalf("GetConfigLanguages: %v", err)
+		}
+		checkField(t, "en", languages[0], tmdb.Language.ISO639_1)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 call before expiry, got %d", got)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if _, err := tmdb.GetConfigLanguages(ctx, client); err != nil {
+		t.Fatalf("GetConfigLanguages: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected a refetch after expiry, got %d calls", got)
+	}
+}
+
+func TestClientCacheRevalidation(t *testing.T) {
+	var fullResponses, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=0")
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		if r.Header.Get("If-None-Match") == `"v1"` && r.Header.Get("If-Modified-Since") == "Wed, 21 Oct 2015 07:28:00 GMT" {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fullResponses.Add(1)
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		w.Write([]byte(`{"id":550,"title":"Fight Club"}`))
+	}))
+	defer server.Close()
+	cache := tmdb.NewMemoryCache(10)
+	client := tmdb.ClientOptions{BaseURL: server.URL, Cache: cache}.NewClient()
+
+	for range 3 {
+		movie, err := tmdb.GetMovie(context.Background(), client, 550)
+		if err != nil {
+			t.Fatalf("GetMovie: %v", err)
+		}
+		checkField(t, "Fight Club", movie, tmdb.Movie.Title)
+	}
+	if got := fullResponses.Load(); got != 1 {
+		t.Errorf("expected 1 full response, got %d", got)
+	}
+	if got := notModified.Load(); got != 2 {
+		t.Errorf("expected 2 not-modified responses, got %d", got)
+	}
+	if entry, ok := cache.Get(server.URL + "/3/movie/550"); !ok || entry.ETag != `"v1"` {
+		t.Errorf("expected cached entry with ETag, got %+v, %v", entry, ok)
+	}
+}
+
+func TestClientCacheControlMaxAge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		w.Write([]byte(`{"id":550}`))
+	}))
+	defer server.Close()
+	cache := tmdb.NewMemoryCache(10)
+	client := tmdb.ClientOptions{BaseURL: server.URL, Cache: cache}.NewClient()
+	if _, err := tmdb.GetMovie(context.Background(), client, 550); err != nil {
+		t.Fatalf("GetMovie: %v", err)
+	}
+	entry, ok := cache.Get(server.URL + "/3/movie/550")
+	if !ok {
+		t.Fatal("expected response to be cached")
+	}
+	if remaining := time.Until(entry.Expires); remaining < 59*time.Minute || remaining > time.Hour {
+		t.Errorf("expected entry to expire in about an hour, got %v", remaining)
+	}
+}
+
+func TestNotModifiedWithoutCacheIsAnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer server.Close()
+	client := tmdb.ClientOptions{BaseURL: server.URL}.NewClient()
+	_, err := tmdb.GetMovie(context.Background(), client, 550, tmdb.WithRequestHeader("If-None-Match", `"v1"`))
+	var apiErr *tmdb.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotModified {
+		t.Fatalf("expected 304 APIError, got %v", err)
+	}
+}
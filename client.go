@@ -1,30 +1,60 @@
 package tmdb
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// Client fetches JSON from TMDB.  Paths are URL-escaped, so segments built from arbitrary strings, like external IDs,
+// must go through url.PathEscape.
 type Client interface {
 	GetObject(ctx context.Context, path string, options ...RequestOption) (Object, error)
 	GetArray(ctx context.Context, path string, options ...RequestOption) (Array, error)
 }
 
+const DefaultBaseURL = "https://api.themoviedb.org"
+
 type ClientOptions struct {
 	APIKey             string
 	APIReadAccessToken string
 	HttpClient         *http.Client
+	// Scheme, host, and optional path prefix that request paths are appended to.  Defaults to DefaultBaseURL.
+	BaseURL     string
+	Retry       RetryPolicy
+	RateLimiter RateLimiter
+	// If set, successful responses are stored in Cache and reused for CacheTTL.  If CacheTTL is not set, the max-age
+	// from the response's Cache-Control header is used, falling back to DefaultCacheTTL.  Once an entry expires it is
+	// revalidated with If-None-Match or If-Modified-Since when the response carried an ETag or Last-Modified header.
+	Cache    Cache
+	CacheTTL time.Duration
+
+	// Defaults for query parameters that are sent with every request, unless a request option sets the same parameter.
+	// Use ValidateDefaults to check them against TMDB's configuration.
+	Language string
+	Region   string
+	// When true, include_adult=true is sent unless a request sets include_adult itself.  Request-specific fields such as
+	// DiscoverMovieQuery.IncludeAdult only send true, so use WithIncludeAdult(false) to turn this off for one request.
+	IncludeAdult         bool
+	IncludeImageLanguage []string
+	IncludeVideoLanguage []string
 }
 
 func (co ClientOptions) NewClient() Client {
 	if co.HttpClient == nil {
 		co.HttpClient = http.DefaultClient
 	}
+	if co.BaseURL == "" {
+		co.BaseURL = DefaultBaseURL
+	}
 	return &clientImpl{
 		options: co,
 	}
@@ -35,6 +65,13 @@ type clientImpl struct {
 }
 
 func (c *clientImpl) getRaw(ctx context.Context, path string, options ...RequestOption) (io.ReadCloser, error) {
+	baseURL, err := url.Parse(c.options.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+	if baseURL.Scheme == "" || baseURL.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q: scheme and host are required", c.options.BaseURL)
+	}
 	if c.options.APIKey != "" {
 		options = append(options, WithQueryParam("api_key", c.options.APIKey))
 	}
@@ -47,10 +84,31 @@ func (c *clientImpl) getRaw(ctx context.Context, path string, options ...Request
 			opt.ChangeValues(&urlValues)
 		}
 	}
+	c.options.applyDefaults(urlValues)
+	rawPath := strings.TrimSuffix(baseURL.EscapedPath(), "/") + "/" + strings.TrimPrefix(path, "/")
+	unescapedPath, err := url.PathUnescape(rawPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	var cacheKey string
+	var cached CacheEntry
+	var revalidate bool
+	if c.options.Cache != nil {
+		cacheKey = newCacheKey(baseURL.Scheme+"://"+baseURL.Host+rawPath, urlValues)
+		var ok bool
+		if cached, ok = c.options.Cache.Get(cacheKey); ok {
+			if cached.fresh(time.Now()) {
+				return io.NopCloser(bytes.NewReader(cached.Body)), nil
+			}
+			revalidate = cached.ETag != "" || cached.LastModified != ""
+		}
+	}
 	reqUrl := &url.URL{
-		Scheme:   "https",
-		Host:     "api.themoviedb.org",
-		Path:     path,
+		Scheme:   baseURL.Scheme,
+		User:     baseURL.User,
+		Host:     baseURL.Host,
+		Path:     unescapedPath,
+		RawPath:  rawPath,
 		RawQuery: urlValues.Encode(),
 	}
 	reqHeader := http.Header{}
@@ -59,6 +117,14 @@ func (c *clientImpl) getRaw(ctx context.Context, path string, options ...Request
 			opt.ChangeHeader(&reqHeader)
 		}
 	}
+	if revalidate {
+		if cached.ETag != "" {
+			reqHeader.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			reqHeader.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
 	req := &http.Request{
 		Method: http.MethodGet,
 		URL:    reqUrl,
@@ -69,9 +135,114 @@ func (c *clientImpl) getRaw(ctx context.Context, path string, options ...Request
 			opt.ChangeRequest(req)
 		}
 	}
-	if ctx != nil {
-		req = req.WithContext(ctx)
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for attempt := 1; ; attempt++ {
+		if c.options.RateLimiter != nil {
+			if err := c.options.RateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		response, err := c.do(req.Clone(ctx), path, options, revalidate)
+		if err == nil {
+			switch {
+			case response.StatusCode == http.StatusNotModified:
+				response.Body.Close()
+				return c.refresh(cacheKey, cached, response.Header), nil
+			case c.options.Cache != nil:
+				return c.store(cacheKey, response)
+			default:
+				return response.Body, nil
+			}
+		}
+		if apiErr, ok := err.(*APIError); ok && c.options.RateLimiter != nil && apiErr.StatusCode == http.StatusTooManyRequests {
+			retryAfter, _ := parseRetryAfter(apiErr.Header, time.Now())
+			c.options.RateLimiter.RateLimited(retryAfter)
+		}
+		delay, retry := c.options.Retry.shouldRetry(ctx, req, attempt, err)
+		if !retry {
+			return nil, err
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// newCacheKey returns the cache key for a request to the given scheme, host and escaped path.  The host is included so
+// that clients with different base URLs can share a cache, and api_key is left out so that keys are stable across
+// credentials.
+func newCacheKey(location string, values url.Values) string {
+	if _, hasApiKey := values["api_key"]; hasApiKey {
+		values = maps.Clone(values)
+		values.Del("api_key")
+	}
+	if len(values) == 0 {
+		return location
 	}
+	return location + "?" + values.Encode()
+}
+
+// store reads the response body into the cache and returns a reader over the cached bytes.
+func (c *clientImpl) store(key string, response *http.Response) (io.ReadCloser, error) {
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if ttl, ok := c.cacheTTL(response.Header); ok && json.Valid(data) {
+		c.options.Cache.Set(key, CacheEntry{
+			Body:         data,
+			Expires:      time.Now().Add(ttl),
+			ETag:         response.Header.Get("ETag"),
+			LastModified: response.Header.Get("Last-Modified"),
+		})
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// refresh extends the lifetime of a cached entry after the server confirmed it is unchanged, and returns a reader over its body.
+func (c *clientImpl) refresh(key string, entry CacheEntry, header http.Header) io.ReadCloser {
+	if ttl, ok := c.cacheTTL(header); ok {
+		entry.Expires = time.Now().Add(ttl)
+		if etag := header.Get("ETag"); etag != "" {
+			entry.ETag = etag
+		}
+		if lastModified := header.Get("Last-Modified"); lastModified != "" {
+			entry.LastModified = lastModified
+		}
+		c.options.Cache.Set(key, entry)
+	}
+	return io.NopCloser(bytes.NewReader(entry.Body))
+}
+
+// cacheTTL returns how long a response with the given headers should be cached for, or false if it must not be cached.
+func (c *clientImpl) cacheTTL(header http.Header) (time.Duration, bool) {
+	maxAge := time.Duration(-1)
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store":
+			return 0, false
+		case "max-age":
+			if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+				maxAge = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+	switch {
+	case c.options.CacheTTL > 0:
+		return c.options.CacheTTL, true
+	case maxAge >= 0:
+		return maxAge, true
+	default:
+		return DefaultCacheTTL, true
+	}
+}
+
+// do sends a single request.  A 304 response is only accepted if the request was a revalidation of a cached entry.
+func (c *clientImpl) do(req *http.Request, path string, options []RequestOption, revalidating bool) (*http.Response, error) {
 	response, err := c.options.HttpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -81,14 +252,39 @@ func (c *clientImpl) getRaw(ctx context.Context, path string, options ...Request
 			opt.ChangeResponse(response)
 		}
 	}
+	if response.StatusCode == http.StatusNotModified && revalidating {
+		return response, nil
+	}
 	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
+		defer response.Body.Close()
+		return nil, newAPIError(path, response)
 	}
 	contentType := response.Header.Get("Content-Type")
 	if !strings.Contains(contentType, "application/json") {
+		response.Body.Close()
 		return nil, fmt.Errorf("unexpected content type: %s", contentType)
 	}
-	return response.Body, nil
+	return response, nil
+}
+
+// TMDB error bodies are small; anything larger than this is not worth decoding.
+const maxErrorBodySize = 64 << 10
+
+func newAPIError(path string, response *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		Path:       path,
+		Header:     response.Header,
+	}
+	var body struct {
+		StatusCode    int32  `json:"status_code"`
+		StatusMessage string `json:"status_message"`
+	}
+	if err := json.NewDecoder(io.LimitReader(response.Body, maxErrorBodySize)).Decode(&body); err == nil {
+		apiErr.TMDBStatusCode = body.StatusCode
+		apiErr.TMDBStatusMessage = body.StatusMessage
+	}
+	return apiErr
 }
 
 func (c *clientImpl) GetObject(ctx context.Context, path string, options ...RequestOption) (Object, error) {
@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -158,3 +159,52 @@ func (tco testClientOptions) newClient(t *testing.T) tmdb.Client {
 
 	return clientOptions.NewClient()
 }
+
+func TestBaseURL(t *testing.T) {
+	var gotPath, gotKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotKey = r.URL.Query().Get("api_key")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":550}`))
+	}))
+	defer server.Close()
+
+	for _, baseURL := range []string{server.URL + "/proxy", server.URL + "/proxy/"} {
+		client := tmdb.ClientOptions{APIKey: "key", BaseURL: baseURL}.NewClient()
+		if _, err := tmdb.GetMovie(context.Background(), client, 550); err != nil {
+			t.Fatalf("GetMovie with base URL %q: %v", baseURL, err)
+		}
+		if gotPath != "/proxy/3/movie/550" {
+			t.Errorf("base URL %q: got path %q, want %q", baseURL, gotPath, "/proxy/3/movie/550")
+		}
+		if gotKey != "key" {
+			t.Errorf("base URL %q: got api_key %q, want %q", baseURL, gotKey, "key")
+		}
+	}
+
+	client := tmdb.ClientOptions{BaseURL: "not a url"}.NewClient()
+	if _, err := tmdb.GetMovie(context.Background(), client, 550); err == nil {
+		t.Error("expected an error for an invalid base URL")
+	}
+}
+
+// newFakeClient returns a client backed by a local test server that serves the given JSON body for each path.
+// Requests for any other path get TMDB's standard 404 response.
+func newFakeClient(t *testing.T, responses map[string]string) tmdb.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			body = `{"success":false,"status_code":34,"status_message":"The resource you requested could not be found."}`
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return tmdb.ClientOptions{
+		APIKey:  "fake-api-key",
+		BaseURL: server.URL,
+	}.NewClient()
+}
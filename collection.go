@@ -29,6 +29,26 @@ func (c Collection) BackdropPath() (string, error) {
 	return jsonflex.GetField(c, "backdrop_path", jsonflex.AsString())
 }
 
+func (c Collection) Images() (Images, error) {
+	return jsonflex.GetField(c, "images", jsonflex.AsObject[Images]())
+}
+
+func (c Collection) BackdropURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := c.BackdropPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindBackdrop, size, path)
+}
+
+func (c Collection) PosterURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := c.PosterPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindPoster, size, path)
+}
+
 func GetCollection(ctx context.Context, client Client, collectionID int32, options ...RequestOption) (Collection, error) {
 	return client.GetObject(ctx, fmt.Sprintf("/3/collection/%d", collectionID), options...)
 }
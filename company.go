@@ -19,3 +19,11 @@ func (c Company) LogoPath() (string, error) {
 func (c Company) OriginCountry() (string, error) {
 	return jsonflex.GetField(c, "origin_country", jsonflex.AsString())
 }
+
+func (c Company) LogoURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := c.LogoPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindLogo, size, path)
+}
@@ -72,6 +72,14 @@ func (c Credit) TotalEpisodeCount() (int32, error) {
 	return jsonflex.GetField(c, "total_episode_count", jsonflex.AsInt32())
 }
 
+func (c Credit) ProfileURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := c.ProfilePath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindProfile, size, path)
+}
+
 type Credits Object
 
 func (c Credits) ID() (int32, error) {
@@ -0,0 +1,88 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+func (co ClientOptions) applyDefaults(values url.Values) {
+	setDefault := func(key, value string) {
+		if value != "" && !values.Has(key) {
+			values.Set(key, value)
+		}
+	}
+	setDefault("language", co.Language)
+	setDefault("region", co.Region)
+	if co.IncludeAdult {
+		setDefault("include_adult", "true")
+	}
+	setDefault("include_image_language", strings.Join(co.IncludeImageLanguage, ","))
+	setDefault("include_video_language", strings.Join(co.IncludeVideoLanguage, ","))
+}
+
+// ValidateDefaults checks the default language and region codes against the ones that TMDB supports, fetching them with
+// GetConfigLanguages and GetConfigCountries.  The returned error wraps ErrInvalidQuery for every unsupported code.
+func (co ClientOptions) ValidateDefaults(ctx context.Context) error {
+	if co.Language == "" && co.Region == "" && len(co.IncludeImageLanguage) == 0 && len(co.IncludeVideoLanguage) == 0 {
+		return nil
+	}
+	client := co.NewClient()
+	languages, err := GetConfigLanguages(ctx, client)
+	if err != nil {
+		return fmt.Errorf("getting languages: %w", err)
+	}
+	knownLanguages := map[string]bool{}
+	for _, language := range languages {
+		code, err := language.ISO639_1()
+		if err != nil {
+			return err
+		}
+		knownLanguages[code] = true
+	}
+	countries, err := GetConfigCountries(ctx, client)
+	if err != nil {
+		return fmt.Errorf("getting countries: %w", err)
+	}
+	knownRegions := map[string]bool{}
+	for _, country := range countries {
+		code, err := country.ISO3166_1()
+		if err != nil {
+			return err
+		}
+		knownRegions[code] = true
+	}
+
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: "+format, append([]any{ErrInvalidQuery}, args...)...))
+	}
+	if co.Language != "" {
+		language, region, hasRegion := strings.Cut(co.Language, "-")
+		if !knownLanguages[language] {
+			fail("Language %q has unknown language %q", co.Language, language)
+		}
+		if hasRegion && !knownRegions[region] {
+			fail("Language %q has unknown region %q", co.Language, region)
+		}
+	}
+	if co.Region != "" && !knownRegions[co.Region] {
+		fail("unknown Region %q", co.Region)
+	}
+	for _, field := range []struct {
+		name      string
+		languages []string
+	}{
+		{"IncludeImageLanguage", co.IncludeImageLanguage},
+		{"IncludeVideoLanguage", co.IncludeVideoLanguage},
+	} {
+		for _, language := range field.languages {
+			if language != "null" && !knownLanguages[language] {
+				fail("%s has unknown language %q", field.name, language)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,146 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestClientDefaults(t *testing.T) {
+	var lastQuery atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastQuery.Store(r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":550}`))
+	}))
+	t.Cleanup(server.Close)
+	client := tmdb.ClientOptions{
+		BaseURL:              server.URL,
+		Language:             "pt-BR",
+		Region:               "BR",
+		IncludeAdult:         true,
+		IncludeImageLanguage: []string{"pt", "null"},
+		IncludeVideoLanguage: []string{"pt", "en"},
+	}.NewClient()
+	ctx := context.Background()
+
+	if _, err := tmdb.GetMovie(ctx, client, 550); err != nil {
+		t.Fatalf("GetMovie: %v", err)
+	}
+	query := lastQuery.Load().(url.Values)
+	for key, want := range map[string]string{
+		"language":               "pt-BR",
+		"region":                 "BR",
+		"include_adult":          "true",
+		"include_image_language": "pt,null",
+		"include_video_language": "pt,en",
+	} {
+		if got := query.Get(key); got != want {
+			t.Errorf("expected %s=%q, got %q", key, want, got)
+		}
+	}
+
+	if _, err := tmdb.GetMovie(ctx, client, 550, tmdb.WithLanguage("en-US"), tmdb.WithIncludeImageLanguage("en")); err != nil {
+		t.Fatalf("GetMovie: %v", err)
+	}
+	query = lastQuery.Load().(url.Values)
+	if got := query["language"]; len(got) != 1 || got[0] != "en-US" {
+		t.Errorf("expected per-call language to override the default, got %v", got)
+	}
+	if got := query.Get("include_image_language"); got != "en" {
+		t.Errorf("expected per-call include_image_language to override the default, got %q", got)
+	}
+	if got := query.Get("region"); got != "BR" {
+		t.Errorf("expected default region to still apply, got %q", got)
+	}
+
+	if _, err := tmdb.GetMovie(ctx, tmdb.ClientOptions{BaseURL: server.URL}.NewClient(), 550); err != nil {
+		t.Fatalf("GetMovie: %v", err)
+	}
+	if query := lastQuery.Load().(url.Values); len(query) != 0 {
+		t.Errorf("expected no query parameters without defaults, got %v", query)
+	}
+}
+
+func TestDiscoverIncludeAdultDefault(t *testing.T) {
+	var lastQuery atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastQuery.Store(r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"page":1,"results":[],"total_pages":0,"total_results":0}`))
+	}))
+	t.Cleanup(server.Close)
+	client := tmdb.ClientOptions{BaseURL: server.URL, IncludeAdult: true}.NewClient()
+	query := func() url.Values { return lastQuery.Load().(url.Values) }
+	ctx := context.Background()
+
+	if _, err := tmdb.DiscoverMovies(ctx, client, tmdb.DiscoverMovieQuery{}); err != nil {
+		t.Fatalf("DiscoverMovies: %v", err)
+	}
+	if got := query().Get("include_adult"); got != "true" {
+		t.Errorf("expected the client default include_adult=true, got %q", got)
+	}
+	if _, err := tmdb.DiscoverMovies(ctx, client, tmdb.DiscoverMovieQuery{}, tmdb.WithIncludeAdult(false)); err != nil {
+		t.Fatalf("DiscoverMovies: %v", err)
+	}
+	if got := query()["include_adult"]; len(got) != 1 || got[0] != "false" {
+		t.Errorf("expected WithIncludeAdult(false) to override the default, got %v", got)
+	}
+	if _, err := tmdb.DiscoverShows(ctx, client, tmdb.DiscoverShowQuery{}, tmdb.WithIncludeAdult(false)); err != nil {
+		t.Fatalf("DiscoverShows: %v", err)
+	}
+	if got := query()["include_adult"]; len(got) != 1 || got[0] != "false" {
+		t.Errorf("expected WithIncludeAdult(false) to override the default, got %v", got)
+	}
+}
+
+func TestValidateDefaults(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/3/configuration/languages":
+			w.Write([]byte(`[{"iso_639_1":"en","english_name":"English","name":"English"},{"iso_639_1":"pt","english_name":"Portuguese","name":"Português"}]`))
+		case "/3/configuration/countries":
+			w.Write([]byte(`[{"iso_3166_1":"US","english_name":"United States of America","native_name":"United States"},{"iso_3166_1":"BR","english_name":"Brazil","native_name":"Brasil"}]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	ctx := context.Background()
+
+	valid := tmdb.ClientOptions{BaseURL: server.URL, Language: "pt-BR", Region: "US", IncludeImageLanguage: []string{"en", "null"}}
+	if err := valid.ValidateDefaults(ctx); err != nil {
+		t.Errorf("expected valid defaults, got %v", err)
+	}
+
+	invalid := tmdb.ClientOptions{
+		BaseURL:              server.URL,
+		Language:             "xx-ZZ",
+		Region:               "br",
+		IncludeVideoLanguage: []string{"en", "klingon"},
+	}
+	err := invalid.ValidateDefaults(ctx)
+	if !errors.Is(err, tmdb.ErrInvalidQuery) {
+		t.Fatalf("expected ErrInvalidQuery, got %v", err)
+	}
+	for _, want := range []string{`unknown language "xx"`, `unknown region "ZZ"`, `unknown Region "br"`, `unknown language "klingon"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to mention %s, got %v", want, err)
+		}
+	}
+
+	calls.Store(0)
+	if err := (tmdb.ClientOptions{BaseURL: server.URL}).ValidateDefaults(ctx); err != nil || calls.Load() != 0 {
+		t.Errorf("expected no requests without defaults, got %d requests, %v", calls.Load(), err)
+	}
+}
@@ -0,0 +1,397 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Match int
+
+const (
+	// Match results that have every ID.
+	MatchAll Match = iota
+	// Match results that have at least one of the IDs.
+	MatchAny
+)
+
+// IDFilter restricts discover results to those associated with some or all of a set of IDs.
+type IDFilter struct {
+	IDs   []int32
+	Match Match
+}
+
+func (f IDFilter) isSet() bool {
+	return len(f.IDs) > 0
+}
+
+func (f IDFilter) String() string {
+	sep := ","
+	if f.Match == MatchAny {
+		sep = "|"
+	}
+	ids := make([]string, len(f.IDs))
+	for i, id := range f.IDs {
+		ids[i] = strconv.FormatInt(int64(id), 10)
+	}
+	return strings.Join(ids, sep)
+}
+
+func (f IDFilter) validate(name string) error {
+	if f.Match != MatchAll && f.Match != MatchAny {
+		return fmt.Errorf("%w: %s has unknown match mode %d", ErrInvalidQuery, name, f.Match)
+	}
+	for _, id := range f.IDs {
+		if id <= 0 {
+			return fmt.Errorf("%w: %s has non-positive ID %d", ErrInvalidQuery, name, id)
+		}
+	}
+	return nil
+}
+
+func (f IDFilter) overlaps(other IDFilter) bool {
+	return slices.ContainsFunc(f.IDs, func(id int32) bool {
+		return slices.Contains(other.IDs, id)
+	})
+}
+
+var (
+	discoverMovieSortFields = []string{"original_title", "popularity", "revenue", "primary_release_date", "title", "vote_average", "vote_count"}
+	discoverShowSortFields  = []string{"first_air_date", "name", "original_name", "popularity", "vote_average", "vote_count"}
+
+	languageCodePattern = regexp.MustCompile(`^[a-z]{2}$`)
+	regionCodePattern   = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// discoverQuery accumulates request options and validation errors while a discover query is converted into a request.
+type discoverQuery struct {
+	opts []RequestOption
+	errs []error
+}
+
+func (q *discoverQuery) fail(format string, args ...any) {
+	q.errs = append(q.errs, fmt.Errorf("%w: "+format, append([]any{ErrInvalidQuery}, args...)...))
+}
+
+func (q *discoverQuery) set(key, value string) {
+	q.opts = append(q.opts, withQueryValue(key, value))
+}
+
+func (q *discoverQuery) sortBy(sortBy string, fields []string) {
+	if sortBy == "" {
+		return
+	}
+	field, dir, ok := strings.Cut(sortBy, ".")
+	if !ok || !slices.Contains(fields, field) || (dir != "asc" && dir != "desc") {
+		q.fail("unsupported sort_by %q", sortBy)
+		return
+	}
+	q.set("sort_by", sortBy)
+}
+
+func (q *discoverQuery) page(page int32) {
+	if page == 0 {
+		return
+	}
+	if page < 1 || page > MaxPages {
+		q.fail("page %d is outside 1-%d", page, MaxPages)
+		return
+	}
+	q.set("page", strconv.FormatInt(int64(page), 10))
+}
+
+func (q *discoverQuery) ids(key string, filter IDFilter) {
+	if !filter.isSet() {
+		return
+	}
+	if err := filter.validate(key); err != nil {
+		q.errs = append(q.errs, err)
+		return
+	}
+	q.set(key, filter.String())
+}
+
+func (q *discoverQuery) without(key string, with, without IDFilter) {
+	if with.overlaps(without) {
+		q.fail("%s and %s share IDs", strings.Replace(key, "without_", "with_", 1), key)
+	}
+	q.ids(key, without)
+}
+
+func (q *discoverQuery) dateRange(prefix string, gte, lte time.Time) {
+	if !gte.IsZero() && !lte.IsZero() && gte.After(lte) {
+		q.fail("%s.gte %s is after %s.lte %s", prefix, gte.Format(time.DateOnly), prefix, lte.Format(time.DateOnly))
+		return
+	}
+	if !gte.IsZero() {
+		q.set(prefix+".gte", gte.Format(time.DateOnly))
+	}
+	if !lte.IsZero() {
+		q.set(prefix+".lte", lte.Format(time.DateOnly))
+	}
+}
+
+func (q *discoverQuery) voteAverage(gte, lte float64) {
+	for _, v := range []float64{gte, lte} {
+		if v < 0 || v > 10 {
+			q.fail("vote_average %v is outside 0-10", v)
+			return
+		}
+	}
+	if gte != 0 && lte != 0 && gte > lte {
+		q.fail("vote_average.gte %v is greater than vote_average.lte %v", gte, lte)
+		return
+	}
+	if gte != 0 {
+		q.set("vote_average.gte", strconv.FormatFloat(gte, 'f', -1, 64))
+	}
+	if lte != 0 {
+		q.set("vote_average.lte", strconv.FormatFloat(lte, 'f', -1, 64))
+	}
+}
+
+func (q *discoverQuery) voteCount(gte int32) {
+	if gte < 0 {
+		q.fail("vote_count.gte %d is negative", gte)
+		return
+	}
+	if gte != 0 {
+		q.set("vote_count.gte", strconv.FormatInt(int64(gte), 10))
+	}
+}
+
+func (q *discoverQuery) runtime(gte, lte int32) {
+	if gte < 0 || lte < 0 {
+		q.fail("runtime bounds %d-%d must not be negative", gte, lte)
+		return
+	}
+	if gte != 0 && lte != 0 && gte > lte {
+		q.fail("with_runtime.gte %d is greater than with_runtime.lte %d", gte, lte)
+		return
+	}
+	if gte != 0 {
+		q.set("with_runtime.gte", strconv.FormatInt(int64(gte), 10))
+	}
+	if lte != 0 {
+		q.set("with_runtime.lte", strconv.FormatInt(int64(lte), 10))
+	}
+}
+
+func (q *discoverQuery) watchProviders(providers IDFilter, region string) {
+	if providers.isSet() && region == "" {
+		q.fail("with_watch_providers requires watch_region")
+	}
+	q.ids("with_watch_providers", providers)
+	q.region("watch_region", region)
+}
+
+func (q *discoverQuery) language(key, language string) {
+	if language == "" {
+		return
+	}
+	if !languageCodePattern.MatchString(language) {
+		q.fail("%s %q is not an ISO 639-1 code", key, language)
+		return
+	}
+	q.set(key, language)
+}
+
+func (q *discoverQuery) region(key, region string) {
+	if region == "" {
+		return
+	}
+	if !regionCodePattern.MatchString(region) {
+		q.fail("%s %q is not an ISO 3166-1 code", key, region)
+		return
+	}
+	q.set(key, region)
+}
+
+func (q *discoverQuery) bool(key string, value bool) {
+	if value {
+		q.set(key, "true")
+	}
+}
+
+func (q *discoverQuery) result() ([]RequestOption, error) {
+	return q.opts, errors.Join(q.errs...)
+}
+
+// DiscoverMovieQuery filters and sorts the results of DiscoverMovies.  Zero-valued fields are left out of the request.
+type DiscoverMovieQuery struct {
+	// One of original_title, popularity, revenue, primary_release_date, title, vote_average, or vote_count, followed by .asc or .desc.
+	SortBy string
+	Page   int32
+	// Only sends include_adult when true, so ClientOptions.IncludeAdult still applies when it is false.  Pass
+	// WithIncludeAdult(false) to DiscoverMovies to override that default.
+	IncludeAdult bool
+	IncludeVideo bool
+
+	Genres           IDFilter
+	WithoutGenres    IDFilter
+	Cast             IDFilter
+	Crew             IDFilter
+	Companies        IDFilter
+	WithoutCompanies IDFilter
+	Keywords         IDFilter
+	WithoutKeywords  IDFilter
+
+	PrimaryReleaseDateGTE time.Time
+	PrimaryReleaseDateLTE time.Time
+	ReleaseDateGTE        time.Time
+	ReleaseDateLTE        time.Time
+
+	VoteAverageGTE float64
+	VoteAverageLTE float64
+	VoteCountGTE   int32
+	// Runtime bounds, in minutes.
+	RuntimeGTE int32
+	RuntimeLTE int32
+
+	// Watch providers are only matched within WatchRegion, which is required if WatchProviders is set.
+	WatchProviders IDFilter
+	WatchRegion    string
+
+	// Certification filters require CertificationCountry.  Certification cannot be combined with the GTE and LTE bounds.
+	Certification        string
+	CertificationGTE     string
+	CertificationLTE     string
+	CertificationCountry string
+
+	OriginalLanguage string
+	Region           string
+}
+
+// Validate reports every malformed or contradictory field in the query.  The returned error wraps ErrInvalidQuery.
+func (dq DiscoverMovieQuery) Validate() error {
+	_, err := dq.options()
+	return err
+}
+
+func (dq DiscoverMovieQuery) options() ([]RequestOption, error) {
+	q := &discoverQuery{}
+	q.sortBy(dq.SortBy, discoverMovieSortFields)
+	q.page(dq.Page)
+	q.bool("include_adult", dq.IncludeAdult)
+	q.bool("include_video", dq.IncludeVideo)
+	q.ids("with_genres", dq.Genres)
+	q.without("without_genres", dq.Genres, dq.WithoutGenres)
+	q.ids("with_cast", dq.Cast)
+	q.ids("with_crew", dq.Crew)
+	q.ids("with_companies", dq.Companies)
+	q.without("without_companies", dq.Companies, dq.WithoutCompanies)
+	q.ids("with_keywords", dq.Keywords)
+	q.without("without_keywords", dq.Keywords, dq.WithoutKeywords)
+	q.dateRange("primary_release_date", dq.PrimaryReleaseDateGTE, dq.PrimaryReleaseDateLTE)
+	q.dateRange("release_date", dq.ReleaseDateGTE, dq.ReleaseDateLTE)
+	q.voteAverage(dq.VoteAverageGTE, dq.VoteAverageLTE)
+	q.voteCount(dq.VoteCountGTE)
+	q.runtime(dq.RuntimeGTE, dq.RuntimeLTE)
+	q.watchProviders(dq.WatchProviders, dq.WatchRegion)
+	if dq.Certification != "" && (dq.CertificationGTE != "" || dq.CertificationLTE != "") {
+		q.fail("certification cannot be combined with certification.gte or certification.lte")
+	}
+	if (dq.Certification != "" || dq.CertificationGTE != "" || dq.CertificationLTE != "") && dq.CertificationCountry == "" {
+		q.fail("certification filters require certification_country")
+	}
+	if dq.Certification != "" {
+		q.set("certification", dq.Certification)
+	}
+	if dq.CertificationGTE != "" {
+		q.set("certification.gte", dq.CertificationGTE)
+	}
+	if dq.CertificationLTE != "" {
+		q.set("certification.lte", dq.CertificationLTE)
+	}
+	q.region("certification_country", dq.CertificationCountry)
+	q.language("with_original_language", dq.OriginalLanguage)
+	q.region("region", dq.Region)
+	return q.result()
+}
+
+// DiscoverShowQuery filters and sorts the results of DiscoverShows.  Zero-valued fields are left out of the request.
+type DiscoverShowQuery struct {
+	// One of first_air_date, name, original_name, popularity, vote_average, or vote_count, followed by .asc or .desc.
+	SortBy string
+	Page   int32
+	// Only sends include_adult when true, so ClientOptions.IncludeAdult still applies when it is false.  Pass
+	// WithIncludeAdult(false) to DiscoverShows to override that default.
+	IncludeAdult bool
+
+	Genres           IDFilter
+	WithoutGenres    IDFilter
+	Companies        IDFilter
+	WithoutCompanies IDFilter
+	Keywords         IDFilter
+	WithoutKeywords  IDFilter
+	Networks         IDFilter
+
+	FirstAirDateGTE time.Time
+	FirstAirDateLTE time.Time
+	AirDateGTE      time.Time
+	AirDateLTE      time.Time
+
+	VoteAverageGTE float64
+	VoteAverageLTE float64
+	VoteCountGTE   int32
+	// Episode runtime bounds, in minutes.
+	RuntimeGTE int32
+	RuntimeLTE int32
+
+	// Watch providers are only matched within WatchRegion, which is required if WatchProviders is set.
+	WatchProviders IDFilter
+	WatchRegion    string
+
+	OriginalLanguage string
+}
+
+// Validate reports every malformed or contradictory field in the query.  The returned error wraps ErrInvalidQuery.
+func (dq DiscoverShowQuery) Validate() error {
+	_, err := dq.options()
+	return err
+}
+
+func (dq DiscoverShowQuery) options() ([]RequestOption, error) {
+	q := &discoverQuery{}
+	q.sortBy(dq.SortBy, discoverShowSortFields)
+	q.page(dq.Page)
+	q.bool("include_adult", dq.IncludeAdult)
+	q.ids("with_genres", dq.Genres)
+	q.without("without_genres", dq.Genres, dq.WithoutGenres)
+	q.ids("with_companies", dq.Companies)
+	q.without("without_companies", dq.Companies, dq.WithoutCompanies)
+	q.ids("with_keywords", dq.Keywords)
+	q.without("without_keywords", dq.Keywords, dq.WithoutKeywords)
+	q.ids("with_networks", dq.Networks)
+	q.dateRange("first_air_date", dq.FirstAirDateGTE, dq.FirstAirDateLTE)
+	q.dateRange("air_date", dq.AirDateGTE, dq.AirDateLTE)
+	q.voteAverage(dq.VoteAverageGTE, dq.VoteAverageLTE)
+	q.voteCount(dq.VoteCountGTE)
+	q.runtime(dq.RuntimeGTE, dq.RuntimeLTE)
+	q.watchProviders(dq.WatchProviders, dq.WatchRegion)
+	q.language("with_original_language", dq.OriginalLanguage)
+	return q.result()
+}
+
+// DiscoverMovies validates query before sending it, and returns an error wrapping ErrInvalidQuery if it is malformed.
+func DiscoverMovies(ctx context.Context, client Client, query DiscoverMovieQuery, opts ...RequestOption) (SearchResults[Movie], error) {
+	queryOpts, err := query.options()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetObject(ctx, "/3/discover/movie", append(queryOpts, opts...)...)
+}
+
+// DiscoverShows validates query before sending it, and returns an error wrapping ErrInvalidQuery if it is malformed.
+func DiscoverShows(ctx context.Context, client Client, query DiscoverShowQuery, opts ...RequestOption) (SearchResults[Show], error) {
+	queryOpts, err := query.options()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetObject(ctx, "/3/discover/tv", append(queryOpts, opts...)...)
+}
@@ -0,0 +1,159 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func newQueryRecordingClient(t *testing.T, body string) (tmdb.Client, func() url.Values, *atomic.Int32) {
+	t.Helper()
+	var lastQuery atomic.Value
+	calls := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		lastQuery.Store(r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	client := tmdb.ClientOptions{BaseURL: server.URL}.NewClient()
+	return client, func() url.Values { v, _ := lastQuery.Load().(url.Values); return v }, calls
+}
+
+func TestDiscoverMovies(t *testing.T) {
+	client, query, _ := newQueryRecordingClient(t, `{"page":1,"results":[{"id":348,"title":"Alien"}],"total_pages":1,"total_results":1}`)
+	results, err := tmdb.DiscoverMovies(context.Background(), client, tmdb.DiscoverMovieQuery{
+		SortBy:                "vote_average.desc",
+		Page:                  2,
+		Genres:                tmdb.IDFilter{IDs: []int32{27, 878}},
+		WithoutGenres:         tmdb.IDFilter{IDs: []int32{35}},
+		Cast:                  tmdb.IDFilter{IDs: []int32{10205, 5049}, Match: tmdb.MatchAny},
+		Crew:                  tmdb.IDFilter{IDs: []int32{578}},
+		Companies:             tmdb.IDFilter{IDs: []int32{25}},
+		Keywords:              tmdb.IDFilter{IDs: []int32{9882}},
+		PrimaryReleaseDateGTE: time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		PrimaryReleaseDateLTE: time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC),
+		VoteAverageGTE:        7.5,
+		VoteCountGTE:          1000,
+		RuntimeGTE:            90,
+		RuntimeLTE:            150,
+		WatchProviders:        tmdb.IDFilter{IDs: []int32{8, 9}, Match: tmdb.MatchAny},
+		WatchRegion:           "US",
+		CertificationLTE:      "R",
+		CertificationCountry:  "US",
+		OriginalLanguage:      "en",
+	})
+	if err != nil {
+		t.Fatalf("DiscoverMovies: %v", err)
+	}
+	checkField(t, "Alien", results, tmdb.SearchResults[tmdb.Movie].Results, index(0), tmdb.Movie.Title)
+	want := url.Values{
+		"sort_by":                  {"vote_average.desc"},
+		"page":                     {"2"},
+		"with_genres":              {"27,878"},
+		"without_genres":           {"35"},
+		"with_cast":                {"10205|5049"},
+		"with_crew":                {"578"},
+		"with_companies":           {"25"},
+		"with_keywords":            {"9882"},
+		"primary_release_date.gte": {"1970-01-01"},
+		"primary_release_date.lte": {"1989-12-31"},
+		"vote_average.gte":         {"7.5"},
+		"vote_count.gte":           {"1000"},
+		"with_runtime.gte":         {"90"},
+		"with_runtime.lte":         {"150"},
+		"with_watch_providers":     {"8|9"},
+		"watch_region":             {"US"},
+		"certification.lte":        {"R"},
+		"certification_country":    {"US"},
+		"with_original_language":   {"en"},
+	}
+	if got := query(); !reflect.DeepEqual(got, want) {
+		t.Errorf("query:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+func TestDiscoverShows(t *testing.T) {
+	client, query, _ := newQueryRecordingClient(t, `{"page":1,"results":[{"id":1396,"name":"Breaking Bad"}],"total_pages":1,"total_results":1}`)
+	results, err := tmdb.DiscoverShows(context.Background(), client, tmdb.DiscoverShowQuery{
+		SortBy:          "first_air_date.asc",
+		Genres:          tmdb.IDFilter{IDs: []int32{18, 80}, Match: tmdb.MatchAny},
+		Networks:        tmdb.IDFilter{IDs: []int32{174}},
+		FirstAirDateGTE: time.Date(2008, 1, 1, 0, 0, 0, 0, time.UTC),
+		IncludeAdult:    true,
+	})
+	if err != nil {
+		t.Fatalf("DiscoverShows: %v", err)
+	}
+	checkField(t, "Breaking Bad", results, tmdb.SearchResults[tmdb.Show].Results, index(0), tmdb.Show.Name)
+	want := url.Values{
+		"sort_by":            {"first_air_date.asc"},
+		"with_genres":        {"18|80"},
+		"with_networks":      {"174"},
+		"first_air_date.gte": {"2008-01-01"},
+		"include_adult":      {"true"},
+	}
+	if got := query(); !reflect.DeepEqual(got, want) {
+		t.Errorf("query:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+func TestDiscoverRejectsInvalidQueries(t *testing.T) {
+	movieTests := map[string]tmdb.DiscoverMovieQuery{
+		"unknown sort field": {SortBy: "name.desc"},
+		"bad sort direction": {SortBy: "popularity.down"},
+		"page too large":     {Page: 501},
+		"genre included and excluded": {
+			Genres:        tmdb.IDFilter{IDs: []int32{27}},
+			WithoutGenres: tmdb.IDFilter{IDs: []int32{27}},
+		},
+		"non-positive id": {Cast: tmdb.IDFilter{IDs: []int32{0}}},
+		"inverted release dates": {
+			PrimaryReleaseDateGTE: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			PrimaryReleaseDateLTE: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"vote average out of range":     {VoteAverageGTE: 11},
+		"inverted vote average":         {VoteAverageGTE: 8, VoteAverageLTE: 6},
+		"inverted runtime":              {RuntimeGTE: 120, RuntimeLTE: 90},
+		"providers without region":      {WatchProviders: tmdb.IDFilter{IDs: []int32{8}}},
+		"lowercase region":              {WatchRegion: "us"},
+		"certification without country": {CertificationLTE: "R"},
+		"exact and ranged certification": {
+			Certification:        "R",
+			CertificationLTE:     "PG-13",
+			CertificationCountry: "US",
+		},
+		"malformed language": {OriginalLanguage: "english"},
+	}
+	client, _, calls := newQueryRecordingClient(t, `{}`)
+	for name, query := range movieTests {
+		t.Run(name, func(t *testing.T) {
+			if err := query.Validate(); !errors.Is(err, tmdb.ErrInvalidQuery) {
+				t.Errorf("Validate: expected ErrInvalidQuery, got %v", err)
+			}
+			if _, err := tmdb.DiscoverMovies(context.Background(), client, query); !errors.Is(err, tmdb.ErrInvalidQuery) {
+				t.Errorf("DiscoverMovies: expected ErrInvalidQuery, got %v", err)
+			}
+		})
+	}
+
+	showQuery := tmdb.DiscoverShowQuery{
+		SortBy:   "revenue.desc",
+		Keywords: tmdb.IDFilter{IDs: []int32{1}, Match: tmdb.Match(7)},
+	}
+	if _, err := tmdb.DiscoverShows(context.Background(), client, showQuery); !errors.Is(err, tmdb.ErrInvalidQuery) {
+		t.Errorf("DiscoverShows: expected ErrInvalidQuery, got %v", err)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("expected invalid queries to send no requests, got %d", got)
+	}
+}
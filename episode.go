@@ -77,6 +77,18 @@ func (e Episode) ExternalIDs() (ExternalIDs, error) {
 	return jsonflex.GetField(e, "external_ids", jsonflex.AsObject[ExternalIDs]())
 }
 
+func (e Episode) Images() (Images, error) {
+	return jsonflex.GetField(e, "images", jsonflex.AsObject[Images]())
+}
+
+func (e Episode) StillURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := e.StillPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindStill, size, path)
+}
+
 func GetEpisode(ctx context.Context, client Client, showID int32, seasonNumber int32, episodeNumber int32, opts ...RequestOption) (Episode, error) {
 	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d/episode/%d", showID, seasonNumber, episodeNumber), opts...)
 }
@@ -1,9 +1,75 @@
 package tmdb
 
-import "github.com/krelinga/go-jsonflex"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/krelinga/go-jsonflex"
+)
 
 var (
 	ErrFieldNotFound = jsonflex.ErrFieldNotFound
 	ErrNullValue     = jsonflex.ErrNullValue
 	ErrCannotConvert = jsonflex.ErrCannotConvert
+
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrRateLimited  = errors.New("rate limited")
+
+	// ErrInvalidQuery is returned, before any request is sent, when query parameters are malformed or contradictory.
+	ErrInvalidQuery = errors.New("invalid query")
+
+	ErrUnsupportedImageSize = errors.New("unsupported image size")
+	ErrUnsupportedVideoSite = errors.New("unsupported video site")
 )
+
+// Status codes that TMDB reports in the status_code field of error responses.
+// See https://developer.themoviedb.org/docs/errors for the full list.
+const (
+	StatusInvalidService       int32 = 2
+	StatusAuthenticationFailed int32 = 3
+	StatusInvalidAPIKey        int32 = 7
+	StatusSuspendedAPIKey      int32 = 10
+	StatusUnauthorized         int32 = 14
+	StatusRequestLimitExceeded int32 = 25
+	StatusResourceNotFound     int32 = 34
+	StatusInvalidToken         int32 = 35
+	StatusNotPermitted         int32 = 36
+)
+
+// APIError is returned when TMDB responds with a non-success status.
+type APIError struct {
+	// HTTP status code of the response.
+	StatusCode int
+	// TMDB-specific status_code and status_message from the response body, if present.
+	TMDBStatusCode    int32
+	TMDBStatusMessage string
+	// Path of the request, without the host or query string.
+	Path string
+	// Headers of the response.
+	Header http.Header
+}
+
+func (e *APIError) Error() string {
+	if e.TMDBStatusMessage != "" {
+		return fmt.Sprintf("tmdb: %s: status %d: %s (code %d)", e.Path, e.StatusCode, e.TMDBStatusMessage, e.TMDBStatusCode)
+	}
+	return fmt.Sprintf("tmdb: %s: unexpected status code: %d", e.Path, e.StatusCode)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.TMDBStatusCode == StatusResourceNotFound
+	case ErrUnauthorized:
+		switch e.TMDBStatusCode {
+		case StatusAuthenticationFailed, StatusInvalidAPIKey, StatusSuspendedAPIKey, StatusUnauthorized, StatusInvalidToken, StatusNotPermitted:
+			return true
+		}
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests || e.TMDBStatusCode == StatusRequestLimitExceeded
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newCannedClient(status int, body string, header http.Header) tmdb.Client {
+	return tmdb.ClientOptions{
+		HttpClient: &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if header == nil {
+					header = http.Header{"Content-Type": {"application/json;charset=utf-8"}}
+				}
+				return &http.Response{
+					StatusCode: status,
+					Header:     header,
+					Body:       io.NopCloser(strings.NewReader(body)),
+					Request:    req,
+				}, nil
+			}),
+		},
+	}.NewClient()
+}
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		wantCode  int32
+		wantMsg   string
+		wantIs    error
+		wantIsNot []error
+	}{
+		{
+			name:      "not found",
+			status:    http.StatusNotFound,
+			body:      `{"success":false,"status_code":34,"status_message":"The resource you requested could not be found."}`,
+			wantCode:  34,
+			wantMsg:   "The resource you requested could not be found.",
+			wantIs:    tmdb.ErrNotFound,
+			wantIsNot: []error{tmdb.ErrUnauthorized, tmdb.ErrRateLimited},
+		},
+		{
+			name:      "invalid api key",
+			status:    http.StatusUnauthorized,
+			body:      `{"success":false,"status_code":7,"status_message":"Invalid API key: You must be granted a valid key."}`,
+			wantCode:  7,
+			wantMsg:   "Invalid API key: You must be granted a valid key.",
+			wantIs:    tmdb.ErrUnauthorized,
+			wantIsNot: []error{tmdb.ErrNotFound, tmdb.ErrRateLimited},
+		},
+		{
+			name:      "rate limited",
+			status:    http.StatusTooManyRequests,
+			body:      `{"success":false,"status_code":25,"status_message":"Your request count (#) is over the allowed limit of (40)."}`,
+			wantCode:  25,
+			wantMsg:   "Your request count (#) is over the allowed limit of (40).",
+			wantIs:    tmdb.ErrRateLimited,
+			wantIsNot: []error{tmdb.ErrNotFound, tmdb.ErrUnauthorized},
+		},
+		{
+			name:      "non-json body",
+			status:    http.StatusBadGateway,
+			body:      `<html>Bad Gateway</html>`,
+			wantIsNot: []error{tmdb.ErrNotFound, tmdb.ErrUnauthorized, tmdb.ErrRateLimited},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newCannedClient(tt.status, tt.body, http.Header{"X-Test": {"yes"}})
+			_, err := tmdb.GetMovie(context.Background(), client, 550)
+			var apiErr *tmdb.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *tmdb.APIError, got %T: %v", err, err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("StatusCode: got %d, want %d", apiErr.StatusCode, tt.status)
+			}
+			if apiErr.TMDBStatusCode != tt.wantCode {
+				t.Errorf("TMDBStatusCode: got %d, want %d", apiErr.TMDBStatusCode, tt.wantCode)
+			}
+			if apiErr.TMDBStatusMessage != tt.wantMsg {
+				t.Errorf("TMDBStatusMessage: got %q, want %q", apiErr.TMDBStatusMessage, tt.wantMsg)
+			}
+			if apiErr.Path != "/3/movie/550" {
+				t.Errorf("Path: got %q, want %q", apiErr.Path, "/3/movie/550")
+			}
+			if apiErr.Header.Get("X-Test") != "yes" {
+				t.Errorf("Header: expected X-Test header to be preserved")
+			}
+			if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+				t.Errorf("expected errors.Is(err, %v)", tt.wantIs)
+			}
+			for _, notErr := range tt.wantIsNot {
+				if errors.Is(err, notErr) {
+					t.Errorf("expected !errors.Is(err, %v)", notErr)
+				}
+			}
+		})
+	}
+}
@@ -49,6 +49,30 @@ func (e ExternalIDs) WikidataID() (string, error) {
 	return jsonflex.GetField(e, "wikidata_id", jsonflex.AsString())
 }
 
+func (e ExternalIDs) TikTokID() (string, error) {
+	return jsonflex.GetField(e, "tiktok_id", jsonflex.AsString())
+}
+
+func (e ExternalIDs) YoutubeID() (string, error) {
+	return jsonflex.GetField(e, "youtube_id", jsonflex.AsString())
+}
+
 func GetMovieExternalIDs(ctx context.Context, client Client, movieID int32, opts ...RequestOption) (ExternalIDs, error) {
 	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d/external_ids", movieID), opts...)
 }
+
+func GetShowExternalIDs(ctx context.Context, client Client, showID int32, opts ...RequestOption) (ExternalIDs, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/external_ids", showID), opts...)
+}
+
+func GetSeasonExternalIDs(ctx context.Context, client Client, showID, seasonNumber int32, opts ...RequestOption) (ExternalIDs, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d/external_ids", showID, seasonNumber), opts...)
+}
+
+func GetEpisodeExternalIDs(ctx context.Context, client Client, showID, seasonNumber, episodeNumber int32, opts ...RequestOption) (ExternalIDs, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d/episode/%d/external_ids", showID, seasonNumber, episodeNumber), opts...)
+}
+
+func GetPersonExternalIDs(ctx context.Context, client Client, personID int32, opts ...RequestOption) (ExternalIDs, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/person/%d/external_ids", personID), opts...)
+}
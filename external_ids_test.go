@@ -0,0 +1,54 @@
+package tmdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestGetExternalIDs(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/550/external_ids":                  `{"id":550,"imdb_id":"tt0137523","wikidata_id":"Q190050","facebook_id":"FightClub","instagram_id":null,"twitter_id":null}`,
+		"/3/tv/1399/external_ids":                    `{"id":1399,"imdb_id":"tt0944947","freebase_mid":"/m/0524b41","freebase_id":"/en/game_of_thrones","tvdb_id":121361,"tvrage_id":24493,"wikidata_id":"Q23572","facebook_id":"GameOfThrones","instagram_id":"gameofthrones","twitter_id":"GameOfThrones"}`,
+		"/3/tv/1399/season/1/external_ids":           `{"id":3624,"freebase_mid":"/m/0gmd1gd","freebase_id":null,"tvdb_id":364731,"tvrage_id":null,"wikidata_id":"Q1658029"}`,
+		"/3/tv/1399/season/1/episode/1/external_ids": `{"id":63056,"imdb_id":"tt1480055","freebase_mid":"/m/0gmc6ph","freebase_id":"/en/winter_is_coming","tvdb_id":3254641,"tvrage_id":1065008299,"wikidata_id":"Q2614622"}`,
+		"/3/person/287/external_ids":                 `{"id":287,"imdb_id":"nm0000093","wikidata_id":"Q35332","facebook_id":null,"instagram_id":"bradpittofflcial","tiktok_id":"bradpitt","twitter_id":null,"youtube_id":"UCbradpitt"}`,
+	})
+	ctx := context.Background()
+
+	if ids, err := tmdb.GetMovieExternalIDs(ctx, client, 550); err != nil {
+		t.Errorf("GetMovieExternalIDs: %v", err)
+	} else {
+		checkField(t, "tt0137523", ids, tmdb.ExternalIDs.IMDBID)
+		checkField(t, "FightClub", ids, tmdb.ExternalIDs.FacebookID)
+	}
+	if ids, err := tmdb.GetShowExternalIDs(ctx, client, 1399); err != nil {
+		t.Errorf("GetShowExternalIDs: %v", err)
+	} else {
+		checkField(t, int32(1399), ids, tmdb.ExternalIDs.ID)
+		checkField(t, int32(121361), ids, tmdb.ExternalIDs.TVDBID)
+		checkField(t, int32(24493), ids, tmdb.ExternalIDs.TVRageID)
+		checkField(t, "gameofthrones", ids, tmdb.ExternalIDs.InstagramID)
+		checkField(t, "GameOfThrones", ids, tmdb.ExternalIDs.TwitterID)
+	}
+	if ids, err := tmdb.GetSeasonExternalIDs(ctx, client, 1399, 1); err != nil {
+		t.Errorf("GetSeasonExternalIDs: %v", err)
+	} else {
+		checkField(t, int32(364731), ids, tmdb.ExternalIDs.TVDBID)
+		checkField(t, "Q1658029", ids, tmdb.ExternalIDs.WikidataID)
+	}
+	if ids, err := tmdb.GetEpisodeExternalIDs(ctx, client, 1399, 1, 1); err != nil {
+		t.Errorf("GetEpisodeExternalIDs: %v", err)
+	} else {
+		checkField(t, int32(3254641), ids, tmdb.ExternalIDs.TVDBID)
+		checkField(t, "tt1480055", ids, tmdb.ExternalIDs.IMDBID)
+	}
+	if ids, err := tmdb.GetPersonExternalIDs(ctx, client, 287); err != nil {
+		t.Errorf("GetPersonExternalIDs: %v", err)
+	} else {
+		checkField(t, "nm0000093", ids, tmdb.ExternalIDs.IMDBID)
+		checkField(t, "bradpitt", ids, tmdb.ExternalIDs.TikTokID)
+		checkField(t, "UCbradpitt", ids, tmdb.ExternalIDs.YoutubeID)
+	}
+}
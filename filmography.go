@@ -0,0 +1,125 @@
+package tmdb
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// FilmographyEntry combines all of a person's cast and crew credits for a single movie or show.
+type FilmographyEntry struct {
+	MediaType MediaType
+	ID        int32
+	// Title of a movie, or name of a show.
+	Title string
+	// Release date of a movie, or first air date of a show.  Empty if TMDB does not know it.
+	Date string
+	// Characters played, in credit order, without duplicates.
+	Characters []string
+	// Crew jobs held, in credit order, without duplicates.
+	Jobs []string
+	// Largest episode count of any TV credit.  Zero for movies.
+	EpisodeCount int32
+	Cast         []PersonCredit
+	Crew         []PersonCredit
+}
+
+// Filmography merges cast and crew credits for the same title and sorts the result by date, oldest first.
+// Titles without a date sort last, and ties are broken by title.
+//
+// Combined credits record whether each credit is for a movie or a show.  Credits from the movie-only or TV-only endpoints
+// do not, so their media type is inferred from whether the credit has a title or a name.
+func (pc PersonCredits) Filmography() ([]FilmographyEntry, error) {
+	cast, err := optional(pc.Cast())
+	if err != nil {
+		return nil, fmt.Errorf("cast: %w", err)
+	}
+	crew, err := optional(pc.Crew())
+	if err != nil {
+		return nil, fmt.Errorf("crew: %w", err)
+	}
+
+	type entryKey struct {
+		mediaType MediaType
+		id        int32
+	}
+	var entries []*FilmographyEntry
+	byKey := map[entryKey]*FilmographyEntry{}
+	add := func(credit PersonCredit, isCast bool) error {
+		id, err := credit.ID()
+		if err != nil {
+			return err
+		}
+		mediaType, err := credit.inferMediaType()
+		if err != nil {
+			return err
+		}
+		key := entryKey{mediaType, id}
+		entry, ok := byKey[key]
+		if !ok {
+			entry = &FilmographyEntry{MediaType: mediaType, ID: id}
+			if mediaType == MediaTypeMovie {
+				entry.Title, _ = optional(credit.Title())
+				entry.Date, _ = optional(credit.ReleaseDate())
+			} else {
+				entry.Title, _ = optional(credit.Name())
+				entry.Date, _ = optional(credit.FirstAirDate())
+			}
+			byKey[key] = entry
+			entries = append(entries, entry)
+		}
+		if episodes, _ := optional(credit.EpisodeCount()); episodes > entry.EpisodeCount {
+			entry.EpisodeCount = episodes
+		}
+		if isCast {
+			entry.Cast = append(entry.Cast, credit)
+			if character, _ := optional(credit.Character()); character != "" && !slices.Contains(entry.Characters, character) {
+				entry.Characters = append(entry.Characters, character)
+			}
+		} else {
+			entry.Crew = append(entry.Crew, credit)
+			if job, _ := optional(credit.Job()); job != "" && !slices.Contains(entry.Jobs, job) {
+				entry.Jobs = append(entry.Jobs, job)
+			}
+		}
+		return nil
+	}
+	for i, credit := range cast {
+		if err := add(credit, true); err != nil {
+			return nil, fmt.Errorf("cast %d: %w", i, err)
+		}
+	}
+	for i, credit := range crew {
+		if err := add(credit, false); err != nil {
+			return nil, fmt.Errorf("crew %d: %w", i, err)
+		}
+	}
+
+	slices.SortStableFunc(entries, func(a, b *FilmographyEntry) int {
+		if (a.Date == "") != (b.Date == "") {
+			if a.Date == "" {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Or(cmp.Compare(a.Date, b.Date), cmp.Compare(a.Title, b.Title))
+	})
+	result := make([]FilmographyEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = *entry
+	}
+	return result, nil
+}
+
+func (pc PersonCredit) inferMediaType() (MediaType, error) {
+	if mediaType, err := optional(pc.MediaType()); err != nil || mediaType != "" {
+		return mediaType, err
+	}
+	if _, hasTitle := pc["title"]; hasTitle {
+		return MediaTypeMovie, nil
+	}
+	if _, hasName := pc["name"]; hasName {
+		return MediaTypeTv, nil
+	}
+	return "", fmt.Errorf("cannot determine media type of credit")
+}
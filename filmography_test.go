@@ -0,0 +1,99 @@
+package tmdb_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+const combinedCreditsJSON = `{
+	"id": 287,
+	"cast": [
+		{"id": 550, "media_type": "movie", "title": "Fight Club", "release_date": "1999-10-15", "character": "Tyler Durden", "credit_id": "a"},
+		{"id": 1668, "media_type": "tv", "name": "Friends", "first_air_date": "1994-09-22", "character": "Will Colbert", "episode_count": 1, "credit_id": "b"},
+		{"id": 999999, "media_type": "movie", "title": "Untitled Project", "release_date": "", "character": "", "credit_id": "c"},
+		{"id": 1668, "media_type": "tv", "name": "Friends", "first_air_date": "1994-09-22", "character": "Himself", "episode_count": 2, "credit_id": "d"}
+	],
+	"crew": [
+		{"id": 550, "media_type": "movie", "title": "Fight Club", "release_date": "1999-10-15", "department": "Production", "job": "Producer", "credit_id": "e"},
+		{"id": 550, "media_type": "movie", "title": "Fight Club", "release_date": "1999-10-15", "department": "Production", "job": "Producer", "credit_id": "f"},
+		{"id": 1668, "media_type": "movie", "title": "Same ID As Friends", "release_date": "2005-01-01", "department": "Writing", "job": "Writer", "credit_id": "g"}
+	]
+}`
+
+func TestFilmography(t *testing.T) {
+	client := newFakeClient(t, map[string]string{"/3/person/287/combined_credits": combinedCreditsJSON})
+	credits, err := tmdb.GetPersonCombinedCredits(context.Background(), client, 287)
+	if err != nil {
+		t.Fatalf("failed to get combined credits: %v", err)
+	}
+	checkField(t, tmdb.MediaTypeTv, credits, tmdb.PersonCredits.Cast, index(1), tmdb.PersonCredit.MediaType)
+
+	filmography, err := credits.Filmography()
+	if err != nil {
+		t.Fatalf("Filmography: %v", err)
+	}
+	var titles []string
+	for _, entry := range filmography {
+		titles = append(titles, entry.Title)
+	}
+	wantTitles := []string{"Friends", "Fight Club", "Same ID As Friends", "Untitled Project"}
+	if !slices.Equal(titles, wantTitles) {
+		t.Fatalf("titles: got %v, want %v", titles, wantTitles)
+	}
+
+	friends := filmography[0]
+	if friends.MediaType != tmdb.MediaTypeTv || friends.ID != 1668 || friends.Date != "1994-09-22" {
+		t.Errorf("unexpected Friends entry: %+v", friends)
+	}
+	if !slices.Equal(friends.Characters, []string{"Will Colbert", "Himself"}) {
+		t.Errorf("Friends characters: got %v", friends.Characters)
+	}
+	if friends.EpisodeCount != 2 || len(friends.Cast) != 2 {
+		t.Errorf("Friends: got episode count %d from %d credits", friends.EpisodeCount, len(friends.Cast))
+	}
+
+	fightClub := filmography[1]
+	if !slices.Equal(fightClub.Characters, []string{"Tyler Durden"}) || !slices.Equal(fightClub.Jobs, []string{"Producer"}) {
+		t.Errorf("Fight Club: got characters %v and jobs %v", fightClub.Characters, fightClub.Jobs)
+	}
+	if len(fightClub.Cast) != 1 || len(fightClub.Crew) != 2 {
+		t.Errorf("Fight Club: got %d cast and %d crew credits", len(fightClub.Cast), len(fightClub.Crew))
+	}
+
+	if untitled := filmography[3]; len(untitled.Characters) != 0 || untitled.Date != "" {
+		t.Errorf("unexpected untitled entry: %+v", untitled)
+	}
+}
+
+func TestFilmographyInfersMediaType(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/person/287/movie_credits": `{"id":287,"cast":[{"id":550,"title":"Fight Club","release_date":"1999-10-15","character":"Tyler Durden"}],"crew":[]}`,
+		"/3/person/287/tv_credits":    `{"id":287,"cast":[{"id":1668,"name":"Friends","first_air_date":"1994-09-22","character":"Will Colbert","episode_count":1}],"crew":[]}`,
+	})
+	movieCredits, err := tmdb.GetPersonMovieCredits(context.Background(), client, 287)
+	if err != nil {
+		t.Fatalf("failed to get movie credits: %v", err)
+	}
+	tvCredits, err := tmdb.GetPersonTvCredits(context.Background(), client, 287)
+	if err != nil {
+		t.Fatalf("failed to get TV credits: %v", err)
+	}
+	for _, tt := range []struct {
+		credits tmdb.PersonCredits
+		want    tmdb.MediaType
+	}{
+		{movieCredits, tmdb.MediaTypeMovie},
+		{tvCredits, tmdb.MediaTypeTv},
+	} {
+		filmography, err := tt.credits.Filmography()
+		if err != nil {
+			t.Fatalf("Filmography: %v", err)
+		}
+		if len(filmography) != 1 || filmography[0].MediaType != tt.want {
+			t.Errorf("expected a single %s entry, got %+v", tt.want, filmography)
+		}
+	}
+}
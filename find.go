@@ -0,0 +1,50 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/krelinga/go-jsonflex"
+)
+
+// ExternalSource names the kind of ID passed to FindByExternalID.
+type ExternalSource string
+
+const (
+	ExternalSourceIMDB      ExternalSource = "imdb_id"
+	ExternalSourceTVDB      ExternalSource = "tvdb_id"
+	ExternalSourceWikidata  ExternalSource = "wikidata_id"
+	ExternalSourceFacebook  ExternalSource = "facebook_id"
+	ExternalSourceInstagram ExternalSource = "instagram_id"
+	ExternalSourceTwitter   ExternalSource = "twitter_id"
+	ExternalSourceTikTok    ExternalSource = "tiktok_id"
+	ExternalSourceYoutube   ExternalSource = "youtube_id"
+)
+
+type FindResults Object
+
+func (f FindResults) MovieResults() ([]Movie, error) {
+	return jsonflex.GetField(f, "movie_results", jsonflex.AsArray(jsonflex.AsObject[Movie]()))
+}
+
+func (f FindResults) PersonResults() ([]Person, error) {
+	return jsonflex.GetField(f, "person_results", jsonflex.AsArray(jsonflex.AsObject[Person]()))
+}
+
+func (f FindResults) TvResults() ([]Show, error) {
+	return jsonflex.GetField(f, "tv_results", jsonflex.AsArray(jsonflex.AsObject[Show]()))
+}
+
+func (f FindResults) TvEpisodeResults() ([]Episode, error) {
+	return jsonflex.GetField(f, "tv_episode_results", jsonflex.AsArray(jsonflex.AsObject[Episode]()))
+}
+
+func (f FindResults) TvSeasonResults() ([]Season, error) {
+	return jsonflex.GetField(f, "tv_season_results", jsonflex.AsArray(jsonflex.AsObject[Season]()))
+}
+
+func FindByExternalID(ctx context.Context, client Client, externalID string, source ExternalSource, opts ...RequestOption) (FindResults, error) {
+	opts = append([]RequestOption{WithQueryParam("external_source", source)}, opts...)
+	return client.GetObject(ctx, fmt.Sprintf("/3/find/%s", url.PathEscape(externalID)), opts...)
+}
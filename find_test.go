@@ -0,0 +1,76 @@
+package tmdb_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestFindByExternalID(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/find/tt0137523": `{
+			"movie_results": [{"id": 550, "title": "Fight Club", "media_type": "movie"}],
+			"person_results": [],
+			"tv_results": [],
+			"tv_episode_results": [],
+			"tv_season_results": []
+		}`,
+		"/3/find/349232": `{
+			"movie_results": [],
+			"person_results": [],
+			"tv_results": [],
+			"tv_episode_results": [{"id": 62085, "name": "Pilot", "season_number": 1, "episode_number": 1, "show_id": 1396}],
+			"tv_season_results": [{"id": 3572, "name": "Season 1", "season_number": 1, "show_id": 1396}]
+		}`,
+		"/3/find/nm0000093": `{"movie_results":[],"person_results":[{"id":287,"name":"Brad Pitt"}],"tv_results":[],"tv_episode_results":[],"tv_season_results":[]}`,
+		"/3/find/81189":     `{"movie_results":[],"person_results":[],"tv_results":[{"id":1396,"name":"Breaking Bad"}],"tv_episode_results":[],"tv_season_results":[]}`,
+	})
+	ctx := context.Background()
+
+	if results, err := tmdb.FindByExternalID(ctx, client, "tt0137523", tmdb.ExternalSourceIMDB); err != nil {
+		t.Errorf("FindByExternalID: %v", err)
+	} else {
+		checkField(t, "Fight Club", results, tmdb.FindResults.MovieResults, index(0), tmdb.Movie.Title)
+		if shows, err := results.TvResults(); err != nil || len(shows) != 0 {
+			t.Errorf("expected no TV results, got %v, %v", shows, err)
+		}
+	}
+	if results, err := tmdb.FindByExternalID(ctx, client, "349232", tmdb.ExternalSourceTVDB); err != nil {
+		t.Errorf("FindByExternalID: %v", err)
+	} else {
+		checkField(t, "Pilot", results, tmdb.FindResults.TvEpisodeResults, index(0), tmdb.Episode.Name)
+		checkField(t, int32(1396), results, tmdb.FindResults.TvEpisodeResults, index(0), tmdb.Episode.ShowID)
+		checkField(t, "Season 1", results, tmdb.FindResults.TvSeasonResults, index(0), tmdb.Season.Name)
+	}
+	if results, err := tmdb.FindByExternalID(ctx, client, "nm0000093", tmdb.ExternalSourceIMDB); err != nil {
+		t.Errorf("FindByExternalID: %v", err)
+	} else {
+		checkField(t, "Brad Pitt", results, tmdb.FindResults.PersonResults, index(0), tmdb.Person.Name)
+	}
+	if results, err := tmdb.FindByExternalID(ctx, client, "81189", tmdb.ExternalSourceTVDB); err != nil {
+		t.Errorf("FindByExternalID: %v", err)
+	} else {
+		checkField(t, "Breaking Bad", results, tmdb.FindResults.TvResults, index(0), tmdb.Show.Name)
+	}
+}
+
+func TestFindByExternalIDEscaping(t *testing.T) {
+	var requestURI string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURI = r.RequestURI
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"movie_results":[],"person_results":[],"tv_results":[],"tv_episode_results":[],"tv_season_results":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	client := tmdb.ClientOptions{BaseURL: server.URL + "/proxy"}.NewClient()
+
+	if _, err := tmdb.FindByExternalID(context.Background(), client, "a/b c?#%", tmdb.ExternalSourceTwitter); err != nil {
+		t.Fatalf("FindByExternalID: %v", err)
+	}
+	if want := "/proxy/3/find/a%2Fb%20c%3F%23%25?external_source=twitter_id"; requestURI != want {
+		t.Errorf("got request URI %q, want %q", requestURI, want)
+	}
+}
@@ -1,6 +1,11 @@
 package tmdb
 
-import "github.com/krelinga/go-jsonflex"
+import (
+	"context"
+	"fmt"
+
+	"github.com/krelinga/go-jsonflex"
+)
 
 type Image jsonflex.Object
 
@@ -32,8 +37,21 @@ func (i Image) Width() (int32, error) {
 	return jsonflex.GetField(i, "width", jsonflex.AsInt32())
 }
 
+// URL returns the URL of the image in the given size.  The kind depends on which list of Images the image came from.
+func (i Image) URL(b *ImageURLBuilder, kind ImageKind, size string) (string, error) {
+	path, err := i.FilePath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(kind, size, path)
+}
+
 type Images jsonflex.Object
 
+func (i Images) ID() (int32, error) {
+	return jsonflex.GetField(i, "id", jsonflex.AsInt32())
+}
+
 func (i Images) Backdrops() ([]Image, error) {
 	return jsonflex.GetField(i, "backdrops", jsonflex.AsArray(jsonflex.AsObject[Image]()))
 }
@@ -44,4 +62,44 @@ func (i Images) Logos() ([]Image, error) {
 
 func (i Images) Posters() ([]Image, error) {
 	return jsonflex.GetField(i, "posters", jsonflex.AsArray(jsonflex.AsObject[Image]()))
-}
\ No newline at end of file
+}
+
+func (i Images) Profiles() ([]Image, error) {
+	return jsonflex.GetField(i, "profiles", jsonflex.AsArray(jsonflex.AsObject[Image]()))
+}
+
+func (i Images) Stills() ([]Image, error) {
+	return jsonflex.GetField(i, "stills", jsonflex.AsArray(jsonflex.AsObject[Image]()))
+}
+
+func GetMovieImages(ctx context.Context, client Client, movieID int32, opts ...RequestOption) (Images, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d/images", movieID), opts...)
+}
+
+func GetShowImages(ctx context.Context, client Client, showID int32, opts ...RequestOption) (Images, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/images", showID), opts...)
+}
+
+func GetSeasonImages(ctx context.Context, client Client, showID, seasonNumber int32, opts ...RequestOption) (Images, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d/images", showID, seasonNumber), opts...)
+}
+
+func GetEpisodeImages(ctx context.Context, client Client, showID, seasonNumber, episodeNumber int32, opts ...RequestOption) (Images, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d/episode/%d/images", showID, seasonNumber, episodeNumber), opts...)
+}
+
+func GetPersonImages(ctx context.Context, client Client, personID int32, opts ...RequestOption) (Images, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/person/%d/images", personID), opts...)
+}
+
+func GetCollectionImages(ctx context.Context, client Client, collectionID int32, opts ...RequestOption) (Images, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/collection/%d/images", collectionID), opts...)
+}
+
+func GetCompanyImages(ctx context.Context, client Client, companyID int32, opts ...RequestOption) (Images, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/company/%d/images", companyID), opts...)
+}
+
+func GetNetworkImages(ctx context.Context, client Client, networkID int32, opts ...RequestOption) (Images, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/network/%d/images", networkID), opts...)
+}
@@ -0,0 +1,261 @@
+package tmdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultImageBaseURL is TMDB's image CDN, as reported by ConfigImages.SecureBaseURL.
+const DefaultImageBaseURL = "https://image.tmdb.org/t/p"
+
+type ImageData struct {
+	Bytes       []byte
+	ContentType string
+}
+
+// ImageCache stores downloaded images, keyed by size and path.  Implementations must be safe for concurrent use.
+type ImageCache interface {
+	Get(key string) (ImageData, bool)
+	Set(key string, data ImageData)
+}
+
+type ImageFetcherOptions struct {
+	// Scheme, host, and path prefix of the image CDN.  Defaults to DefaultImageBaseURL.
+	BaseURL string
+	// Usually the same client as ClientOptions.HttpClient.  Defaults to http.DefaultClient.
+	HttpClient *http.Client
+	Cache      ImageCache
+}
+
+func (o ImageFetcherOptions) NewImageFetcher() *ImageFetcher {
+	if o.HttpClient == nil {
+		o.HttpClient = http.DefaultClient
+	}
+	if o.BaseURL == "" {
+		o.BaseURL = DefaultImageBaseURL
+	}
+	o.BaseURL = strings.TrimSuffix(o.BaseURL, "/")
+	return &ImageFetcher{
+		options:  o,
+		inflight: map[string]*imageFetch{},
+	}
+}
+
+// ImageFetcher downloads images from TMDB's image CDN.  Concurrent requests for the same image share a single download.
+type ImageFetcher struct {
+	options  ImageFetcherOptions
+	mu       sync.Mutex
+	inflight map[string]*imageFetch
+}
+
+type imageFetch struct {
+	done    chan struct{}
+	data    ImageData
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// Fetch downloads the image at path in the given size, which should come from ImageURLBuilder.Sizes or be ImageSizeOriginal.
+//
+// As with ImageURLBuilder.URL, an SVG path is fetched as its PNG rendition for any size other than ImageSizeOriginal.
+//
+// The response's content type must match the path's extension.  If ctx is canceled, Fetch returns early.  A download shared
+// with other callers keeps going for their benefit, and is canceled once every caller waiting for it has returned.
+func (f *ImageFetcher) Fetch(ctx context.Context, size, imagePath string) (ImageData, error) {
+	if imagePath == "" {
+		return ImageData{}, fmt.Errorf("empty image path")
+	}
+	key := sizedImagePath(size, imagePath)
+	if f.options.Cache != nil {
+		if data, ok := f.options.Cache.Get(key); ok {
+			return data, nil
+		}
+	}
+
+	f.mu.Lock()
+	fetch, ok := f.inflight[key]
+	if !ok {
+		downloadCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		fetch = &imageFetch{done: make(chan struct{}), cancel: cancel}
+		f.inflight[key] = fetch
+		go func() {
+			defer cancel()
+			fetch.data, fetch.err = f.download(downloadCtx, key)
+			if fetch.err == nil && f.options.Cache != nil {
+				f.options.Cache.Set(key, fetch.data)
+			}
+			f.mu.Lock()
+			f.forget(key, fetch)
+			f.mu.Unlock()
+			close(fetch.done)
+		}()
+	}
+	fetch.waiters++
+	f.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		f.mu.Lock()
+		if fetch.waiters--; fetch.waiters == 0 {
+			// Nobody is left to use the result, so stop the download and let the next caller start a fresh one.
+			fetch.cancel()
+			f.forget(key, fetch)
+		}
+		f.mu.Unlock()
+		return ImageData{}, ctx.Err()
+	case <-fetch.done:
+		return fetch.data, fetch.err
+	}
+}
+
+// forget removes fetch from the in-flight downloads, unless it was already replaced.  f.mu must be held.
+func (f *ImageFetcher) forget(key string, fetch *imageFetch) {
+	if f.inflight[key] == fetch {
+		delete(f.inflight, key)
+	}
+}
+
+func (f *ImageFetcher) download(ctx context.Context, key string) (ImageData, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.options.BaseURL+"/"+key, nil)
+	if err != nil {
+		return ImageData{}, err
+	}
+	response, err := f.options.HttpClient.Do(req)
+	if err != nil {
+		return ImageData{}, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return ImageData{}, newAPIError(req.URL.Path, response)
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return ImageData{}, fmt.Errorf("failed to read image: %w", err)
+	}
+	contentType, err := checkImageContentType(key, response.Header.Get("Content-Type"), body)
+	if err != nil {
+		return ImageData{}, err
+	}
+	return ImageData{Bytes: body, ContentType: contentType}, nil
+}
+
+var imageContentTypes = map[string]string{
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".svg":  "image/svg+xml",
+	".webp": "image/webp",
+}
+
+// checkImageContentType verifies that an image's content type matches its extension, sniffing the body if the server did not say.
+func checkImageContentType(imagePath, header string, body []byte) (string, error) {
+	want, ok := imageContentTypes[strings.ToLower(path.Ext(imagePath))]
+	if !ok {
+		return "", fmt.Errorf("unsupported image extension in %q", imagePath)
+	}
+	got, _, _ := mime.ParseMediaType(header)
+	if got == "" || got == "application/octet-stream" {
+		got, _, _ = mime.ParseMediaType(http.DetectContentType(body))
+	}
+	// Content sniffing, ours or the server's, cannot recognize SVG, which is XML.
+	if want == "image/svg+xml" && (got == "text/xml" || got == "text/plain") && bytes.Contains(body, []byte("<svg")) {
+		got = want
+	}
+	if got != want {
+		return "", fmt.Errorf("unexpected content type %q for %q, want %q", got, imagePath, want)
+	}
+	return got, nil
+}
+
+// DiskImageCache is a content-addressed ImageCache.  Each distinct image is stored once, named by the SHA-256 of its bytes,
+// and each key refers to the image it was last set to.  Errors reading or writing the directory are treated as cache misses.
+type DiskImageCache struct {
+	dir string
+}
+
+// NewDiskImageCache returns a DiskImageCache that stores images in dir, creating it if needed.
+func NewDiskImageCache(dir string) (*DiskImageCache, error) {
+	for _, sub := range []string{"blobs", "refs"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return &DiskImageCache{dir: dir}, nil
+}
+
+type diskImageRef struct {
+	Key         string `json:"key"`
+	Blob        string `json:"blob"`
+	ContentType string `json:"content_type"`
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (dc *DiskImageCache) refPath(key string) string {
+	return filepath.Join(dc.dir, "refs", sha256Hex([]byte(key))+".json")
+}
+
+func (dc *DiskImageCache) blobPath(blob string) string {
+	return filepath.Join(dc.dir, "blobs", blob)
+}
+
+func (dc *DiskImageCache) Get(key string) (ImageData, bool) {
+	refData, err := os.ReadFile(dc.refPath(key))
+	if err != nil {
+		return ImageData{}, false
+	}
+	var ref diskImageRef
+	if err := json.Unmarshal(refData, &ref); err != nil || ref.Key != key {
+		return ImageData{}, false
+	}
+	blob, err := os.ReadFile(dc.blobPath(ref.Blob))
+	if err != nil || sha256Hex(blob) != ref.Blob {
+		return ImageData{}, false
+	}
+	return ImageData{Bytes: blob, ContentType: ref.ContentType}, true
+}
+
+func (dc *DiskImageCache) Set(key string, data ImageData) {
+	blob := sha256Hex(data.Bytes)
+	if _, err := os.Stat(dc.blobPath(blob)); err != nil {
+		if !dc.writeFile(dc.blobPath(blob), data.Bytes) {
+			return
+		}
+	}
+	refData, err := json.Marshal(diskImageRef{Key: key, Blob: blob, ContentType: data.ContentType})
+	if err != nil {
+		return
+	}
+	dc.writeFile(dc.refPath(key), refData)
+}
+
+// writeFile writes to a temporary file and renames it so that concurrent readers never see a partial file.
+func (dc *DiskImageCache) writeFile(name string, data []byte) bool {
+	tmp, err := os.CreateTemp(dc.dir, ".tmp-*")
+	if err != nil {
+		return false
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil || os.Rename(tmp.Name(), name) != nil {
+		os.Remove(tmp.Name())
+		return false
+	}
+	return true
+}
@@ -0,0 +1,214 @@
+package tmdb_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func pngBytes(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.White)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+const svgLogo = `<?xml version="1.0" encoding="UTF-8"?><svg xmlns="http://www.w3.org/2000/svg" width="10" height="10"></svg>`
+
+// newFakeCDN serves PNG and SVG images, counting requests per path.  Requests wait on release, if it is not nil.
+func newFakeCDN(t *testing.T, release <-chan struct{}) (*httptest.Server, func(string) int32) {
+	t.Helper()
+	pngData := pngBytes(t)
+	var mu sync.Mutex
+	counts := map[string]*atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if counts[r.URL.Path] == nil {
+			counts[r.URL.Path] = &atomic.Int32{}
+		}
+		counts[r.URL.Path].Add(1)
+		mu.Unlock()
+		if release != nil {
+			<-release
+		}
+		switch r.URL.Path {
+		case "/t/p/w92/logo.png", "/t/p/w500/network.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(pngData)
+		case "/t/p/original/network.svg":
+			w.Header().Set("Content-Type", "image/svg+xml")
+			w.Write([]byte(svgLogo))
+		case "/t/p/original/unlabeled.svg":
+			w.Write([]byte(svgLogo))
+		case "/t/p/w92/mislabeled.jpg":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(pngData)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, func(path string) int32 {
+		mu.Lock()
+		defer mu.Unlock()
+		if counts[path] == nil {
+			return 0
+		}
+		return counts[path].Load()
+	}
+}
+
+func TestImageFetcher(t *testing.T) {
+	server, count := newFakeCDN(t, nil)
+	fetcher := tmdb.ImageFetcherOptions{BaseURL: server.URL + "/t/p/"}.NewImageFetcher()
+	ctx := context.Background()
+
+	data, err := fetcher.Fetch(ctx, "w92", "/logo.png")
+	if err != nil {
+		t.Fatalf("Fetch png: %v", err)
+	}
+	if data.ContentType != "image/png" || !bytes.Equal(data.Bytes, pngBytes(t)) {
+		t.Errorf("unexpected png data: %q, %d bytes", data.ContentType, len(data.Bytes))
+	}
+
+	if data, err := fetcher.Fetch(ctx, tmdb.ImageSizeOriginal, "/network.svg"); err != nil || data.ContentType != "image/svg+xml" {
+		t.Errorf("Fetch original svg: got %q, %v", data.ContentType, err)
+	}
+	if data, err := fetcher.Fetch(ctx, "w500", "/network.svg"); err != nil || data.ContentType != "image/png" {
+		t.Errorf("Fetch sized svg: got %q, %v", data.ContentType, err)
+	}
+	if count("/t/p/w500/network.png") != 1 {
+		t.Errorf("expected sized svg to be fetched as png")
+	}
+	if data, err := fetcher.Fetch(ctx, tmdb.ImageSizeOriginal, "/unlabeled.svg"); err != nil || data.ContentType != "image/svg+xml" {
+		t.Errorf("Fetch unlabeled svg: got %q, %v", data.ContentType, err)
+	}
+
+	if _, err := fetcher.Fetch(ctx, "w92", "/mislabeled.jpg"); err == nil {
+		t.Error("expected an error when the content type does not match the extension")
+	}
+	var apiErr *tmdb.APIError
+	if _, err := fetcher.Fetch(ctx, "w92", "/missing.jpg"); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 APIError, got %v", err)
+	}
+}
+
+func TestImageFetcherDeduplicates(t *testing.T) {
+	release := make(chan struct{})
+	server, count := newFakeCDN(t, release)
+	fetcher := tmdb.ImageFetcherOptions{BaseURL: server.URL + "/t/p"}.NewImageFetcher()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := fetcher.Fetch(context.Background(), "w92", "/logo.png")
+			errs <- err
+		}()
+	}
+	// Wait for the shared download to reach the server before letting it finish.
+	for count("/t/p/w92/logo.png") == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Fetch: %v", err)
+		}
+	}
+	if got := count("/t/p/w92/logo.png"); got != 1 {
+		t.Errorf("expected 1 download, got %d", got)
+	}
+}
+
+func TestDiskImageCache(t *testing.T) {
+	server, count := newFakeCDN(t, nil)
+	dir := t.TempDir()
+	cache, err := tmdb.NewDiskImageCache(dir)
+	if err != nil {
+		t.Fatalf("NewDiskImageCache: %v", err)
+	}
+	fetcher := tmdb.ImageFetcherOptions{BaseURL: server.URL + "/t/p", Cache: cache}.NewImageFetcher()
+	for range 2 {
+		if _, err := fetcher.Fetch(context.Background(), "w92", "/logo.png"); err != nil {
+			t.Fatalf("Fetch: %v", err)
+		}
+	}
+	if got := count("/t/p/w92/logo.png"); got != 1 {
+		t.Errorf("expected 1 download, got %d", got)
+	}
+
+	// Identical images under different keys share storage.
+	data, _ := cache.Get("w92/logo.png")
+	cache.Set("w92/copy.png", data)
+	reopened, err := tmdb.NewDiskImageCache(dir)
+	if err != nil {
+		t.Fatalf("NewDiskImageCache: %v", err)
+	}
+	copied, ok := reopened.Get("w92/copy.png")
+	if !ok || copied.ContentType != "image/png" || !bytes.Equal(copied.Bytes, data.Bytes) {
+		t.Errorf("expected copy to round-trip, got %q, %v", copied.ContentType, ok)
+	}
+	blobs, err := os.ReadDir(filepath.Join(dir, "blobs"))
+	if err != nil || len(blobs) != 1 {
+		t.Errorf("expected 1 blob, got %d, %v", len(blobs), err)
+	}
+}
+
+func TestImageFetcherCancelsAbandonedDownload(t *testing.T) {
+	var calls atomic.Int32
+	canceled := make(chan struct{}, 2)
+	pngData := pngBytes(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			// Stall the first download until the client gives up on it.
+			<-r.Context().Done()
+			canceled <- struct{}{}
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(pngData)
+	}))
+	t.Cleanup(server.Close)
+	fetcher := tmdb.ImageFetcherOptions{BaseURL: server.URL}.NewImageFetcher()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := fetcher.Fetch(ctx, "w92", "/logo.png"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the abandoned download to be canceled")
+	}
+
+	if data, err := fetcher.Fetch(context.Background(), "w92", "/logo.png"); err != nil || !bytes.Equal(data.Bytes, pngData) {
+		t.Fatalf("expected a fresh download to succeed, got %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 downloads, got %d", got)
+	}
+}
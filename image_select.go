@@ -0,0 +1,117 @@
+package tmdb
+
+import (
+	"math"
+	"slices"
+)
+
+// ImageCriteria ranks a list of Images, such as the Posters() or Backdrops() of any Images set.
+type ImageCriteria struct {
+	// Languages lists ISO 639-1 codes in order of preference.  Use "" for images with no language, which for backdrops
+	// means no text.  Images in an unlisted language rank after all listed ones.  If empty, language is ignored.
+	Languages []string
+
+	// Images smaller than MinWidth or MinHeight are excluded.
+	MinWidth  int32
+	MinHeight int32
+
+	// If AspectRatio is set, images whose aspect ratio differs from it by more than AspectRatioTolerance are excluded.
+	AspectRatio          float64
+	AspectRatioTolerance float64
+}
+
+type rankedImage struct {
+	image       Image
+	language    int
+	voteAverage float64
+	voteCount   int32
+	width       int32
+}
+
+// Rank returns the images that meet the criteria, best first.  Images are ordered by language preference, then by
+// VoteAverage(), VoteCount() and Width(), all descending.
+func (c ImageCriteria) Rank(images []Image) ([]Image, error) {
+	var ranked []rankedImage
+	for _, image := range images {
+		r, ok, err := c.rank(image)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ranked = append(ranked, r)
+		}
+	}
+	slices.SortStableFunc(ranked, func(a, b rankedImage) int {
+		switch {
+		case a.language != b.language:
+			return a.language - b.language
+		case a.voteAverage != b.voteAverage:
+			if a.voteAverage > b.voteAverage {
+				return -1
+			}
+			return 1
+		case a.voteCount != b.voteCount:
+			return int(b.voteCount - a.voteCount)
+		default:
+			return int(b.width - a.width)
+		}
+	})
+	result := make([]Image, len(ranked))
+	for i, r := range ranked {
+		result[i] = r.image
+	}
+	return result, nil
+}
+
+// Best returns the best image that meets the criteria, or false if there is none.
+func (c ImageCriteria) Best(images []Image) (Image, bool, error) {
+	ranked, err := c.Rank(images)
+	if err != nil || len(ranked) == 0 {
+		return nil, false, err
+	}
+	return ranked[0], true, nil
+}
+
+func (c ImageCriteria) rank(image Image) (rankedImage, bool, error) {
+	r := rankedImage{image: image}
+	var err error
+	if r.width, err = optional(image.Width()); err != nil {
+		return r, false, err
+	}
+	height, err := optional(image.Height())
+	if err != nil {
+		return r, false, err
+	}
+	if r.width < c.MinWidth || height < c.MinHeight {
+		return r, false, nil
+	}
+	if c.AspectRatio > 0 {
+		aspectRatio, err := optional(image.AspectRatio())
+		if err != nil {
+			return r, false, err
+		}
+		if aspectRatio == 0 && height > 0 {
+			aspectRatio = float64(r.width) / float64(height)
+		}
+		if math.Abs(aspectRatio-c.AspectRatio) > c.AspectRatioTolerance {
+			return r, false, nil
+		}
+	}
+	if len(c.Languages) > 0 {
+		language, err := optional(image.ISO639_1())
+		if err != nil {
+			return r, false, err
+		}
+		r.language = slices.Index(c.Languages, language)
+		if r.language < 0 {
+			r.language = len(c.Languages)
+		}
+	}
+	if r.voteAverage, err = optional(image.VoteAverage()); err != nil {
+		return r, false, err
+	}
+	if r.voteCount, err = optional(image.VoteCount()); err != nil {
+		return r, false, err
+	}
+	return r, true, nil
+}
@@ -0,0 +1,88 @@
+package tmdb_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+const backdropsJSON = `[
+	{"file_path": "/en.jpg", "iso_639_1": "en", "width": 3840, "height": 2160, "aspect_ratio": 1.778, "vote_average": 5.0, "vote_count": 3},
+	{"file_path": "/none-low.jpg", "iso_639_1": null, "width": 1920, "height": 1080, "aspect_ratio": 1.778, "vote_average": 5.2, "vote_count": 2},
+	{"file_path": "/none-high.jpg", "iso_639_1": null, "width": 1920, "height": 1080, "aspect_ratio": 1.778, "vote_average": 5.4, "vote_count": 1},
+	{"file_path": "/none-small.jpg", "iso_639_1": null, "width": 1280, "height": 720, "aspect_ratio": 1.778, "vote_average": 9.0, "vote_count": 9},
+	{"file_path": "/none-square.jpg", "iso_639_1": null, "width": 2000, "height": 2000, "aspect_ratio": 1.0, "vote_average": 9.0, "vote_count": 9},
+	{"file_path": "/fr.jpg", "iso_639_1": "fr", "width": 1920, "height": 1080, "aspect_ratio": 1.778, "vote_average": 9.0, "vote_count": 9},
+	{"file_path": "/en-tie.jpg", "iso_639_1": "en", "width": 1920, "height": 1080, "aspect_ratio": 1.778, "vote_average": 5.0, "vote_count": 3}
+]`
+
+func imagePaths(t *testing.T, images []tmdb.Image) []string {
+	t.Helper()
+	paths := make([]string, len(images))
+	for i, image := range images {
+		path, err := image.FilePath()
+		if err != nil {
+			t.Fatalf("FilePath: %v", err)
+		}
+		paths[i] = path
+	}
+	return paths
+}
+
+func TestImageCriteria(t *testing.T) {
+	var images []tmdb.Image
+	if err := json.Unmarshal([]byte(backdropsJSON), &images); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		criteria tmdb.ImageCriteria
+		want     []string
+	}{
+		{
+			name:     "no criteria",
+			criteria: tmdb.ImageCriteria{},
+			want:     []string{"/none-square.jpg", "/fr.jpg", "/none-small.jpg", "/none-high.jpg", "/none-low.jpg", "/en.jpg", "/en-tie.jpg"},
+		},
+		{
+			name: "textless first",
+			criteria: tmdb.ImageCriteria{
+				Languages:            []string{"", "en"},
+				MinWidth:             1920,
+				AspectRatio:          16.0 / 9.0,
+				AspectRatioTolerance: 0.01,
+			},
+			want: []string{"/none-high.jpg", "/none-low.jpg", "/en.jpg", "/en-tie.jpg", "/fr.jpg"},
+		},
+		{
+			name:     "english first",
+			criteria: tmdb.ImageCriteria{Languages: []string{"en", ""}, MinHeight: 1080},
+			want:     []string{"/en.jpg", "/en-tie.jpg", "/none-square.jpg", "/none-high.jpg", "/none-low.jpg", "/fr.jpg"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked, err := tt.criteria.Rank(images)
+			if err != nil {
+				t.Fatalf("Rank: %v", err)
+			}
+			if got := imagePaths(t, ranked); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			best, ok, err := tt.criteria.Best(images)
+			if err != nil || !ok {
+				t.Fatalf("Best: %v, %v", ok, err)
+			}
+			if got := imagePaths(t, []tmdb.Image{best}); got[0] != tt.want[0] {
+				t.Errorf("Best: got %v, want %v", got[0], tt.want[0])
+			}
+		})
+	}
+
+	if _, ok, err := (tmdb.ImageCriteria{MinWidth: 10000}).Best(images); ok || err != nil {
+		t.Errorf("expected no image, got %v, %v", ok, err)
+	}
+}
@@ -0,0 +1,74 @@
+package tmdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestGetImages(t *testing.T) {
+	const poster = `{"aspect_ratio":0.667,"height":3000,"iso_639_1":"en","file_path":"/poster.jpg","vote_average":5.3,"vote_count":12,"width":2000}`
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/550/images":                  `{"id":550,"backdrops":[],"logos":[],"posters":[` + poster + `]}`,
+		"/3/tv/1399/images":                    `{"id":1399,"backdrops":[],"logos":[],"posters":[` + poster + `]}`,
+		"/3/tv/1399/season/1/images":           `{"id":3624,"posters":[` + poster + `]}`,
+		"/3/tv/1399/season/1/episode/1/images": `{"id":63056,"stills":[{"aspect_ratio":1.778,"height":1080,"iso_639_1":null,"file_path":"/still.jpg","vote_average":5.4,"vote_count":4,"width":1920}]}`,
+		"/3/person/287/images":                 `{"id":287,"profiles":[{"aspect_ratio":0.667,"height":1500,"iso_639_1":null,"file_path":"/profile.jpg","vote_average":5.5,"vote_count":8,"width":1000}]}`,
+		"/3/collection/10/images":              `{"id":10,"backdrops":[],"posters":[` + poster + `]}`,
+		"/3/company/1/images":                  `{"id":1,"logos":[{"aspect_ratio":2.5,"height":100,"iso_639_1":null,"file_path":"/logo.svg","file_type":".svg","vote_average":0,"vote_count":0,"width":250}]}`,
+		"/3/network/49/images":                 `{"id":49,"logos":[{"aspect_ratio":2.5,"height":100,"iso_639_1":null,"file_path":"/hbo.png","file_type":".png","vote_average":0,"vote_count":0,"width":250}]}`,
+	})
+	ctx := context.Background()
+
+	posterTests := []struct {
+		name string
+		get  func() (tmdb.Images, error)
+		id   int32
+	}{
+		{"GetMovieImages", func() (tmdb.Images, error) { return tmdb.GetMovieImages(ctx, client, 550) }, 550},
+		{"GetShowImages", func() (tmdb.Images, error) { return tmdb.GetShowImages(ctx, client, 1399) }, 1399},
+		{"GetSeasonImages", func() (tmdb.Images, error) { return tmdb.GetSeasonImages(ctx, client, 1399, 1) }, 3624},
+		{"GetCollectionImages", func() (tmdb.Images, error) { return tmdb.GetCollectionImages(ctx, client, 10) }, 10},
+	}
+	for _, tt := range posterTests {
+		if images, err := tt.get(); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		} else {
+			checkField(t, tt.id, images, tmdb.Images.ID)
+			checkField(t, "/poster.jpg", images, tmdb.Images.Posters, index(0), tmdb.Image.FilePath)
+			checkField(t, int32(2000), images, tmdb.Images.Posters, index(0), tmdb.Image.Width)
+		}
+	}
+
+	if images, err := tmdb.GetEpisodeImages(ctx, client, 1399, 1, 1); err != nil {
+		t.Errorf("GetEpisodeImages: %v", err)
+	} else {
+		checkField(t, "/still.jpg", images, tmdb.Images.Stills, index(0), tmdb.Image.FilePath)
+	}
+	if images, err := tmdb.GetPersonImages(ctx, client, 287); err != nil {
+		t.Errorf("GetPersonImages: %v", err)
+	} else {
+		checkField(t, "/profile.jpg", images, tmdb.Images.Profiles, index(0), tmdb.Image.FilePath)
+	}
+	if images, err := tmdb.GetCompanyImages(ctx, client, 1); err != nil {
+		t.Errorf("GetCompanyImages: %v", err)
+	} else {
+		checkField(t, "/logo.svg", images, tmdb.Images.Logos, index(0), tmdb.Image.FilePath)
+	}
+	if images, err := tmdb.GetNetworkImages(ctx, client, 49); err != nil {
+		t.Errorf("GetNetworkImages: %v", err)
+	} else {
+		checkField(t, "/hbo.png", images, tmdb.Images.Logos, index(0), tmdb.Image.FilePath)
+	}
+}
+
+func TestIncludeImageLanguage(t *testing.T) {
+	client, query, _ := newQueryRecordingClient(t, `{"id":550,"posters":[]}`)
+	if _, err := tmdb.GetMovieImages(context.Background(), client, 550, tmdb.WithIncludeImageLanguage("en", "null")); err != nil {
+		t.Fatalf("GetMovieImages: %v", err)
+	}
+	if got := query().Get("include_image_language"); got != "en,null" {
+		t.Errorf("expected include_image_language=en,null, got %q", got)
+	}
+}
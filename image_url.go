@@ -0,0 +1,128 @@
+package tmdb
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type ImageKind string
+
+const (
+	ImageKindBackdrop ImageKind = "backdrop"
+	ImageKindLogo     ImageKind = "logo"
+	ImageKindPoster   ImageKind = "poster"
+	ImageKindProfile  ImageKind = "profile"
+	ImageKindStill    ImageKind = "still"
+)
+
+// ImageSizeOriginal requests an image at the resolution it was uploaded at.  It is valid for every kind of image.
+const ImageSizeOriginal = "original"
+
+// ImageURLBuilder turns image paths like "/abc.jpg" into full URLs on TMDB's image CDN, using the sizes that GetConfigDetails reports.
+type ImageURLBuilder struct {
+	baseURL string
+	sizes   map[ImageKind][]string
+}
+
+func NewImageURLBuilder(config ConfigDetails) (*ImageURLBuilder, error) {
+	images, err := config.Images()
+	if err != nil {
+		return nil, fmt.Errorf("images: %w", err)
+	}
+	baseURL, err := images.SecureBaseURL()
+	if err != nil {
+		return nil, fmt.Errorf("secure_base_url: %w", err)
+	}
+	b := &ImageURLBuilder{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		sizes:   map[ImageKind][]string{},
+	}
+	for kind, getSizes := range map[ImageKind]func() ([]string, error){
+		ImageKindBackdrop: images.BackdropSizes,
+		ImageKindLogo:     images.LogoSizes,
+		ImageKindPoster:   images.PosterSizes,
+		ImageKindProfile:  images.ProfileSizes,
+		ImageKindStill:    images.StillSizes,
+	} {
+		sizes, err := getSizes()
+		if err != nil {
+			return nil, fmt.Errorf("%s sizes: %w", kind, err)
+		}
+		b.sizes[kind] = sizes
+	}
+	return b, nil
+}
+
+// Sizes returns the sizes that TMDB serves for the given kind of image, like "w92" or "h632".
+func (b *ImageURLBuilder) Sizes(kind ImageKind) []string {
+	return slices.Clone(b.sizes[kind])
+}
+
+// URL returns the URL of the image at path in the given size.  The size must be one of Sizes(kind), or ImageSizeOriginal.
+//
+// TMDB only serves SVG logos at their original size, so for any other size an SVG path is replaced by the PNG rendition
+// that TMDB serves at the same path with a .png extension.
+func (b *ImageURLBuilder) URL(kind ImageKind, size, path string) (string, error) {
+	if path == "" {
+		return "", errors.New("empty image path")
+	}
+	sizes, ok := b.sizes[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown image kind %q", kind)
+	}
+	if size != ImageSizeOriginal && !slices.Contains(sizes, size) {
+		return "", fmt.Errorf("%w: %q is not a %s size; valid sizes are %v", ErrUnsupportedImageSize, size, kind, sizes)
+	}
+	return b.baseURL + "/" + sizedImagePath(size, path), nil
+}
+
+// sizedImagePath returns the path of an image in the given size, relative to the image CDN's base URL.
+func sizedImagePath(size, imagePath string) string {
+	if ext := path.Ext(imagePath); size != ImageSizeOriginal && strings.EqualFold(ext, ".svg") {
+		imagePath = strings.TrimSuffix(imagePath, ext) + ".png"
+	}
+	return size + "/" + strings.TrimPrefix(imagePath, "/")
+}
+
+// SizeForWidth returns the smallest width-based size of the given kind that is at least minWidth pixels wide,
+// or ImageSizeOriginal if every size is narrower.
+func (b *ImageURLBuilder) SizeForWidth(kind ImageKind, minWidth int) (string, error) {
+	sizes, ok := b.sizes[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown image kind %q", kind)
+	}
+	best, bestWidth := ImageSizeOriginal, 0
+	for _, size := range sizes {
+		width, ok := sizeWidth(size)
+		if !ok || width < minWidth {
+			continue
+		}
+		if bestWidth == 0 || width < bestWidth {
+			best, bestWidth = size, width
+		}
+	}
+	return best, nil
+}
+
+// URLForWidth combines SizeForWidth and URL.
+func (b *ImageURLBuilder) URLForWidth(kind ImageKind, minWidth int, path string) (string, error) {
+	size, err := b.SizeForWidth(kind, minWidth)
+	if err != nil {
+		return "", err
+	}
+	return b.URL(kind, size, path)
+}
+
+// sizeWidth parses the width out of a size like "w300".  Height-based sizes like "h632" have no width.
+func sizeWidth(size string) (int, bool) {
+	digits, ok := strings.CutPrefix(size, "w")
+	if !ok {
+		return 0, false
+	}
+	width, err := strconv.Atoi(digits)
+	return width, err == nil
+}
@@ -0,0 +1,107 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+const configDetailsJSON = `{
+	"change_keys": ["adult", "air_date"],
+	"images": {
+		"base_url": "http://image.tmdb.org/t/p/",
+		"secure_base_url": "https://image.tmdb.org/t/p/",
+		"backdrop_sizes": ["w300", "w780", "w1280", "original"],
+		"logo_sizes": ["w45", "w92", "w154", "w185", "w300", "w500", "original"],
+		"poster_sizes": ["w92", "w154", "w185", "w342", "w500", "w780", "original"],
+		"profile_sizes": ["w45", "w185", "h632", "original"],
+		"still_sizes": ["w92", "w185", "w300", "original"]
+	}
+}`
+
+func newTestImageURLBuilder(t *testing.T) *tmdb.ImageURLBuilder {
+	t.Helper()
+	client := newFakeClient(t, map[string]string{"/3/configuration": configDetailsJSON})
+	config, err := tmdb.GetConfigDetails(context.Background(), client)
+	if err != nil {
+		t.Fatalf("GetConfigDetails: %v", err)
+	}
+	b, err := tmdb.NewImageURLBuilder(config)
+	if err != nil {
+		t.Fatalf("NewImageURLBuilder: %v", err)
+	}
+	return b
+}
+
+func TestImageURLBuilder(t *testing.T) {
+	b := newTestImageURLBuilder(t)
+
+	if got, err := b.URL(tmdb.ImageKindPoster, "w500", "/jSziioSwPVrOy9Yow3XhWIBDjq1.jpg"); err != nil || got != "https://image.tmdb.org/t/p/w500/jSziioSwPVrOy9Yow3XhWIBDjq1.jpg" {
+		t.Errorf("poster URL: got %q, %v", got, err)
+	}
+	if got, err := b.URL(tmdb.ImageKindStill, tmdb.ImageSizeOriginal, "/still.jpg"); err != nil || got != "https://image.tmdb.org/t/p/original/still.jpg" {
+		t.Errorf("original still URL: got %q, %v", got, err)
+	}
+	if _, err := b.URL(tmdb.ImageKindBackdrop, "w500", "/backdrop.jpg"); !errors.Is(err, tmdb.ErrUnsupportedImageSize) {
+		t.Errorf("expected ErrUnsupportedImageSize for a poster size on a backdrop, got %v", err)
+	}
+	if _, err := b.URL(tmdb.ImageKindPoster, "w500", ""); err == nil {
+		t.Error("expected an error for an empty path")
+	}
+
+	sizeTests := []struct {
+		kind     tmdb.ImageKind
+		minWidth int
+		want     string
+	}{
+		{tmdb.ImageKindPoster, 0, "w92"},
+		{tmdb.ImageKindPoster, 200, "w342"},
+		{tmdb.ImageKindPoster, 342, "w342"},
+		{tmdb.ImageKindPoster, 1000, tmdb.ImageSizeOriginal},
+		{tmdb.ImageKindProfile, 300, tmdb.ImageSizeOriginal},
+		{tmdb.ImageKindBackdrop, 1000, "w1280"},
+	}
+	for _, tt := range sizeTests {
+		if got, err := b.SizeForWidth(tt.kind, tt.minWidth); err != nil || got != tt.want {
+			t.Errorf("SizeForWidth(%s, %d): got %q, %v, want %q", tt.kind, tt.minWidth, got, err, tt.want)
+		}
+	}
+	if got, err := b.URLForWidth(tmdb.ImageKindLogo, 100, "/logo.svg"); err != nil || got != "https://image.tmdb.org/t/p/w154/logo.png" {
+		t.Errorf("URLForWidth: got %q, %v", got, err)
+	}
+	if got, err := b.URL(tmdb.ImageKindLogo, tmdb.ImageSizeOriginal, "/logo.svg"); err != nil || got != "https://image.tmdb.org/t/p/original/logo.svg" {
+		t.Errorf("URL: got %q, %v", got, err)
+	}
+}
+
+func TestImageURLConvenienceMethods(t *testing.T) {
+	b := newTestImageURLBuilder(t)
+	movie := tmdb.Movie{"poster_path": "/poster.jpg", "backdrop_path": "/backdrop.jpg"}
+	if got, err := movie.PosterURL(b, "w185"); err != nil || got != "https://image.tmdb.org/t/p/w185/poster.jpg" {
+		t.Errorf("Movie.PosterURL: got %q, %v", got, err)
+	}
+	if got, err := movie.BackdropURL(b, "w780"); err != nil || got != "https://image.tmdb.org/t/p/w780/backdrop.jpg" {
+		t.Errorf("Movie.BackdropURL: got %q, %v", got, err)
+	}
+	episode := tmdb.Episode{"still_path": "/still.jpg"}
+	if got, err := episode.StillURL(b, "w300"); err != nil || got != "https://image.tmdb.org/t/p/w300/still.jpg" {
+		t.Errorf("Episode.StillURL: got %q, %v", got, err)
+	}
+	credit := tmdb.Credit{"profile_path": "/profile.jpg"}
+	if got, err := credit.ProfileURL(b, "h632"); err != nil || got != "https://image.tmdb.org/t/p/h632/profile.jpg" {
+		t.Errorf("Credit.ProfileURL: got %q, %v", got, err)
+	}
+	company := tmdb.Company{"logo_path": "/logo.png"}
+	if got, err := company.LogoURL(b, "w45"); err != nil || got != "https://image.tmdb.org/t/p/w45/logo.png" {
+		t.Errorf("Company.LogoURL: got %q, %v", got, err)
+	}
+	image := tmdb.Image{"file_path": "/image.jpg"}
+	if got, err := image.URL(b, tmdb.ImageKindBackdrop, "w1280"); err != nil || got != "https://image.tmdb.org/t/p/w1280/image.jpg" {
+		t.Errorf("Image.URL: got %q, %v", got, err)
+	}
+	if _, err := (tmdb.Show{"poster_path": nil}).PosterURL(b, "w92"); !errors.Is(err, tmdb.ErrNullValue) {
+		t.Errorf("expected ErrNullValue for a null poster path, got %v", err)
+	}
+}
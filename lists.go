@@ -0,0 +1,73 @@
+package tmdb
+
+import (
+	"context"
+
+	"github.com/krelinga/go-jsonflex"
+)
+
+// DatedResults is a page of results that also reports the range of release dates it covers.
+// It has the same shape as SearchResults, so it can be converted to one, for example to use with AllResults.
+type DatedResults[T ~Object] Object
+
+func (d DatedResults[T]) Dates() (DateRange, error) {
+	return jsonflex.GetField(d, "dates", jsonflex.AsObject[DateRange]())
+}
+
+func (d DatedResults[T]) Page() (int32, error) {
+	return jsonflex.GetField(d, "page", jsonflex.AsInt32())
+}
+
+func (d DatedResults[T]) Results() ([]T, error) {
+	return jsonflex.GetField(d, "results", jsonflex.AsArray(jsonflex.AsObject[T]()))
+}
+
+func (d DatedResults[T]) TotalResults() (int32, error) {
+	return jsonflex.GetField(d, "total_results", jsonflex.AsInt32())
+}
+
+func (d DatedResults[T]) TotalPages() (int32, error) {
+	return jsonflex.GetField(d, "total_pages", jsonflex.AsInt32())
+}
+
+type DateRange Object
+
+func (d DateRange) Maximum() (string, error) {
+	return jsonflex.GetField(d, "maximum", jsonflex.AsString())
+}
+
+func (d DateRange) Minimum() (string, error) {
+	return jsonflex.GetField(d, "minimum", jsonflex.AsString())
+}
+
+func GetMoviesNowPlaying(ctx context.Context, client Client, opts ...RequestOption) (DatedResults[Movie], error) {
+	return client.GetObject(ctx, "/3/movie/now_playing", opts...)
+}
+
+func GetMoviesPopular(ctx context.Context, client Client, opts ...RequestOption) (SearchResults[Movie], error) {
+	return client.GetObject(ctx, "/3/movie/popular", opts...)
+}
+
+func GetMoviesTopRated(ctx context.Context, client Client, opts ...RequestOption) (SearchResults[Movie], error) {
+	return client.GetObject(ctx, "/3/movie/top_rated", opts...)
+}
+
+func GetMoviesUpcoming(ctx context.Context, client Client, opts ...RequestOption) (DatedResults[Movie], error) {
+	return client.GetObject(ctx, "/3/movie/upcoming", opts...)
+}
+
+func GetShowsAiringToday(ctx context.Context, client Client, opts ...RequestOption) (SearchResults[Show], error) {
+	return client.GetObject(ctx, "/3/tv/airing_today", opts...)
+}
+
+func GetShowsOnTheAir(ctx context.Context, client Client, opts ...RequestOption) (SearchResults[Show], error) {
+	return client.GetObject(ctx, "/3/tv/on_the_air", opts...)
+}
+
+func GetShowsPopular(ctx context.Context, client Client, opts ...RequestOption) (SearchResults[Show], error) {
+	return client.GetObject(ctx, "/3/tv/popular", opts...)
+}
+
+func GetShowsTopRated(ctx context.Context, client Client, opts ...RequestOption) (SearchResults[Show], error) {
+	return client.GetObject(ctx, "/3/tv/top_rated", opts...)
+}
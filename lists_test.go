@@ -0,0 +1,85 @@
+package tmdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestMovieLists(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/now_playing": `{"dates":{"maximum":"2025-07-16","minimum":"2025-06-04"},"page":1,"results":[{"id":1061474,"title":"Superman"}],"total_pages":1,"total_results":1}`,
+		"/3/movie/upcoming":    `{"dates":{"maximum":"2025-08-06","minimum":"2025-07-17"},"page":1,"results":[{"id":617126,"title":"The Fantastic 4: First Steps"}],"total_pages":1,"total_results":1}`,
+		"/3/movie/popular":     `{"page":1,"results":[{"id":550,"title":"Fight Club"}],"total_pages":1,"total_results":1}`,
+		"/3/movie/top_rated":   `{"page":1,"results":[{"id":238,"title":"The Godfather"}],"total_pages":1,"total_results":1}`,
+	})
+	ctx := context.Background()
+
+	nowPlaying, err := tmdb.GetMoviesNowPlaying(ctx, client, tmdb.WithRegion("US"), tmdb.WithLanguage("en-US"))
+	if err != nil {
+		t.Fatalf("GetMoviesNowPlaying: %v", err)
+	}
+	checkField(t, "2025-07-16", nowPlaying, tmdb.DatedResults[tmdb.Movie].Dates, tmdb.DateRange.Maximum)
+	checkField(t, "2025-06-04", nowPlaying, tmdb.DatedResults[tmdb.Movie].Dates, tmdb.DateRange.Minimum)
+	checkField(t, "Superman", nowPlaying, tmdb.DatedResults[tmdb.Movie].Results, index(0), tmdb.Movie.Title)
+	checkField(t, int32(1), nowPlaying, tmdb.DatedResults[tmdb.Movie].Page)
+	checkField(t, int32(1), nowPlaying, tmdb.DatedResults[tmdb.Movie].TotalPages)
+	checkField(t, int32(1), nowPlaying, tmdb.DatedResults[tmdb.Movie].TotalResults)
+
+	upcoming, err := tmdb.GetMoviesUpcoming(ctx, client)
+	if err != nil {
+		t.Fatalf("GetMoviesUpcoming: %v", err)
+	}
+	checkField(t, "2025-07-17", upcoming, tmdb.DatedResults[tmdb.Movie].Dates, tmdb.DateRange.Minimum)
+
+	// Dated results can be paged through like any other results.
+	count := 0
+	for movie, err := range tmdb.AllResults(ctx, func(ctx context.Context, page int32) (tmdb.SearchResults[tmdb.Movie], error) {
+		results, err := tmdb.GetMoviesUpcoming(ctx, client, tmdb.WithPage(page))
+		return tmdb.SearchResults[tmdb.Movie](results), err
+	}, tmdb.PageOptions{}) {
+		if err != nil {
+			t.Fatalf("AllResults: %v", err)
+		}
+		checkField(t, int32(617126), movie, tmdb.Movie.ID)
+		count++
+	}
+	if count != 1 {
+		t.Errorf("expected 1 upcoming movie, got %d", count)
+	}
+
+	if popular, err := tmdb.GetMoviesPopular(ctx, client); err != nil {
+		t.Errorf("GetMoviesPopular: %v", err)
+	} else {
+		checkField(t, "Fight Club", popular, tmdb.SearchResults[tmdb.Movie].Results, index(0), tmdb.Movie.Title)
+	}
+	if topRated, err := tmdb.GetMoviesTopRated(ctx, client); err != nil {
+		t.Errorf("GetMoviesTopRated: %v", err)
+	} else {
+		checkField(t, "The Godfather", topRated, tmdb.SearchResults[tmdb.Movie].Results, index(0), tmdb.Movie.Title)
+	}
+}
+
+func TestShowLists(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/tv/airing_today": `{"page":1,"results":[{"id":1,"name":"Airing Today"}],"total_pages":1,"total_results":1}`,
+		"/3/tv/on_the_air":   `{"page":1,"results":[{"id":2,"name":"On The Air"}],"total_pages":1,"total_results":1}`,
+		"/3/tv/popular":      `{"page":1,"results":[{"id":3,"name":"Popular"}],"total_pages":1,"total_results":1}`,
+		"/3/tv/top_rated":    `{"page":1,"results":[{"id":4,"name":"Top Rated"}],"total_pages":1,"total_results":1}`,
+	})
+	ctx := context.Background()
+	for name, get := range map[string]func(context.Context, tmdb.Client, ...tmdb.RequestOption) (tmdb.SearchResults[tmdb.Show], error){
+		"Airing Today": tmdb.GetShowsAiringToday,
+		"On The Air":   tmdb.GetShowsOnTheAir,
+		"Popular":      tmdb.GetShowsPopular,
+		"Top Rated":    tmdb.GetShowsTopRated,
+	} {
+		results, err := get(ctx, client, tmdb.WithLanguage("en-US"))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		checkField(t, name, results, tmdb.SearchResults[tmdb.Show].Results, index(0), tmdb.Show.Name)
+	}
+}
@@ -0,0 +1,177 @@
+package tmdb
+
+import (
+	"slices"
+	"strings"
+)
+
+// Localized holds a movie's or show's fields in the language that best matches a user's preferences.
+type Localized struct {
+	Title      string
+	Overview   string
+	Tagline    string
+	PosterPath string
+}
+
+// Localize resolves the movie's fields for a list of preferred languages, most preferred first.  Each preference is an
+// ISO 639-1 code, optionally followed by an ISO 3166-1 region, like "pt-BR" or "en".
+//
+// The movie must be fetched with WithAppendToResponse("translations"); append "images" as well to localize the poster.
+// Each field comes from the first preference with an exact translation, then from the first preference whose language
+// matches in any region.  Empty translations are skipped.  Otherwise, the title falls back to OriginalTitle(), and the
+// other fields to the movie's own, which are in the language it was fetched in.
+func (m Movie) Localize(preferences ...string) (Localized, error) {
+	return localize(localizeSource{
+		translations:     m.Translations,
+		images:           m.Images,
+		title:            TranslationData.Title,
+		originalTitle:    m.OriginalTitle,
+		overview:         m.Overview,
+		tagline:          m.Tagline,
+		posterPath:       m.PosterPath,
+		originalLanguage: m.OriginalLanguage,
+	}, preferences)
+}
+
+// Localize resolves the show's fields for a list of preferred languages, in the same way as Movie.Localize.  The title
+// falls back to OriginalName().
+func (s Show) Localize(preferences ...string) (Localized, error) {
+	return localize(localizeSource{
+		translations:     s.Translations,
+		images:           s.Images,
+		title:            TranslationData.Name,
+		originalTitle:    s.OriginalName,
+		overview:         s.Overview,
+		tagline:          s.Tagline,
+		posterPath:       s.PosterPath,
+		originalLanguage: s.OriginalLanguage,
+	}, preferences)
+}
+
+type localizeSource struct {
+	translations     func() (Translations, error)
+	images           func() (Images, error)
+	title            func(TranslationData) (string, error)
+	originalTitle    func() (string, error)
+	overview         func() (string, error)
+	tagline          func() (string, error)
+	posterPath       func() (string, error)
+	originalLanguage func() (string, error)
+}
+
+type languagePreference struct {
+	language string
+	region   string
+}
+
+func localize(source localizeSource, preferences []string) (Localized, error) {
+	var prefs []languagePreference
+	for _, p := range preferences {
+		language, region, _ := strings.Cut(p, "-")
+		prefs = append(prefs, languagePreference{language: strings.ToLower(language), region: strings.ToUpper(region)})
+	}
+	translations, err := optional(source.translations())
+	if err != nil {
+		return Localized{}, err
+	}
+	var list []Translation
+	if translations != nil {
+		if list, err = optional(translations.Translations()); err != nil {
+			return Localized{}, err
+		}
+	}
+
+	var l Localized
+	for _, field := range []struct {
+		dest     *string
+		get      func(TranslationData) (string, error)
+		fallback func() (string, error)
+	}{
+		{&l.Title, source.title, source.originalTitle},
+		{&l.Overview, TranslationData.Overview, source.overview},
+		{&l.Tagline, TranslationData.Tagline, source.tagline},
+	} {
+		if *field.dest, err = translatedField(list, prefs, field.get); err != nil {
+			return Localized{}, err
+		}
+		if *field.dest == "" {
+			if *field.dest, err = optional(field.fallback()); err != nil {
+				return Localized{}, err
+			}
+		}
+	}
+	if l.PosterPath, err = localizedPoster(source, prefs); err != nil {
+		return Localized{}, err
+	}
+	return l, nil
+}
+
+// translatedField returns the first non-empty value among exact matches for prefs, then language-only matches.
+func translatedField(translations []Translation, prefs []languagePreference, get func(TranslationData) (string, error)) (string, error) {
+	for _, exact := range []bool{true, false} {
+		for _, pref := range prefs {
+			for _, t := range translations {
+				language, err := optional(t.ISO639_1())
+				if err != nil {
+					return "", err
+				}
+				region, err := optional(t.ISO3166_1())
+				if err != nil {
+					return "", err
+				}
+				if language != pref.language || (exact && pref.region != "" && region != pref.region) {
+					continue
+				}
+				data, err := optional(t.Data())
+				if err != nil {
+					return "", err
+				}
+				if value, err := optional(get(data)); err != nil {
+					return "", err
+				} else if value != "" {
+					return value, nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// localizedPoster picks the best poster in a preferred language, then a textless one, then one in the original
+// language.  If none of the appended images qualify, it falls back to the poster that TMDB picked.
+func localizedPoster(source localizeSource, prefs []languagePreference) (string, error) {
+	images, err := optional(source.images())
+	if err != nil {
+		return "", err
+	}
+	if images == nil {
+		return optional(source.posterPath())
+	}
+	posters, err := optional(images.Posters())
+	if err != nil {
+		return "", err
+	}
+	originalLanguage, err := optional(source.originalLanguage())
+	if err != nil {
+		return "", err
+	}
+	var languages []string
+	for _, pref := range prefs {
+		languages = append(languages, pref.language)
+	}
+	languages = append(languages, "", originalLanguage)
+	ranked, err := ImageCriteria{Languages: languages}.Rank(posters)
+	if err != nil {
+		return "", err
+	}
+	for _, poster := range ranked {
+		language, err := optional(poster.ISO639_1())
+		if err != nil {
+			return "", err
+		}
+		if slices.Contains(languages, language) {
+			return poster.FilePath()
+		}
+	}
+	return optional(source.posterPath())
+}
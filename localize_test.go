@@ -0,0 +1,82 @@
+package tmdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestLocalize(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/550": `{"id":550,"title":"Fight Club","original_title":"Fight Club","original_language":"en","overview":"A ticking-time-bomb insomniac...","tagline":"Mischief. Mayhem. Soap.","poster_path":"/default.jpg",
+			"translations":{"translations":[
+				{"iso_3166_1":"PT","iso_639_1":"pt","name":"Português","english_name":"Portuguese","data":{"title":"Clube de Combate","overview":"Um empregado de escritório...","tagline":""}},
+				{"iso_3166_1":"BR","iso_639_1":"pt","name":"Português","english_name":"Portuguese","data":{"title":"Clube da Luta","overview":"","tagline":"Caos. Confusão. Sabão."}},
+				{"iso_3166_1":"ES","iso_639_1":"es","name":"Español","english_name":"Spanish","data":{"title":"El club de la lucha","overview":"Un joven...","tagline":""}}
+			]},
+			"images":{"posters":[
+				{"iso_639_1":"en","file_path":"/en.jpg","vote_average":5.5,"vote_count":10,"width":2000,"height":3000},
+				{"iso_639_1":"pt","file_path":"/pt.jpg","vote_average":5.1,"vote_count":2,"width":1000,"height":1500},
+				{"iso_639_1":"ja","file_path":"/ja.jpg","vote_average":9.0,"vote_count":99,"width":2000,"height":3000}
+			]}}`,
+		"/3/tv/1399": `{"id":1399,"name":"Game of Thrones","original_name":"Game of Thrones","original_language":"en","overview":"Seven noble families...","tagline":"Winter is coming.","poster_path":"/got.jpg",
+			"translations":{"translations":[
+				{"iso_3166_1":"DE","iso_639_1":"de","name":"Deutsch","english_name":"German","data":{"name":"Game of Thrones – Das Lied von Eis und Feuer","overview":"Sieben Adelsfamilien...","tagline":""}}
+			]}}`,
+	})
+	ctx := context.Background()
+	movie, err := tmdb.GetMovie(ctx, client, 550, tmdb.WithAppendToResponse("translations", "images"))
+	if err != nil {
+		t.Fatalf("GetMovie: %v", err)
+	}
+	show, err := tmdb.GetShow(ctx, client, 1399, tmdb.WithAppendToResponse("translations"))
+	if err != nil {
+		t.Fatalf("GetShow: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  func() (tmdb.Localized, error)
+		want tmdb.Localized
+	}{
+		{
+			// Exact matches come first, and empty translations fall through the chain.
+			name: "movie pt-BR",
+			got:  func() (tmdb.Localized, error) { return movie.Localize("pt-BR", "pt-PT", "en-US") },
+			want: tmdb.Localized{Title: "Clube da Luta", Overview: "Um empregado de escritório...", Tagline: "Caos. Confusão. Sabão.", PosterPath: "/pt.jpg"},
+		},
+		{
+			// A language-only match is used when no region matches, and the original language poster beats other languages.
+			name: "movie es-MX",
+			got:  func() (tmdb.Localized, error) { return movie.Localize("es-MX") },
+			want: tmdb.Localized{Title: "El club de la lucha", Overview: "Un joven...", Tagline: "Mischief. Mayhem. Soap.", PosterPath: "/en.jpg"},
+		},
+		{
+			name: "movie untranslated",
+			got:  func() (tmdb.Localized, error) { return movie.Localize("fr-FR") },
+			want: tmdb.Localized{Title: "Fight Club", Overview: "A ticking-time-bomb insomniac...", Tagline: "Mischief. Mayhem. Soap.", PosterPath: "/en.jpg"},
+		},
+		{
+			name: "show de",
+			got:  func() (tmdb.Localized, error) { return show.Localize("de") },
+			want: tmdb.Localized{Title: "Game of Thrones – Das Lied von Eis und Feuer", Overview: "Sieben Adelsfamilien...", Tagline: "Winter is coming.", PosterPath: "/got.jpg"},
+		},
+		{
+			name: "show untranslated",
+			got:  func() (tmdb.Localized, error) { return show.Localize("it-IT") },
+			want: tmdb.Localized{Title: "Game of Thrones", Overview: "Seven noble families...", Tagline: "Winter is coming.", PosterPath: "/got.jpg"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.got()
+			if err != nil {
+				t.Fatalf("Localize: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
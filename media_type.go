@@ -0,0 +1,9 @@
+package tmdb
+
+type MediaType string
+
+const (
+	MediaTypeMovie  MediaType = "movie"
+	MediaTypeTv     MediaType = "tv"
+	MediaTypePerson MediaType = "person"
+)
@@ -11,6 +11,14 @@ func GetMovie(ctx context.Context, client Client, movieID int32, opts ...Request
 	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d", movieID), opts...)
 }
 
+func GetMovieRecommendations(ctx context.Context, client Client, movieID int32, opts ...RequestOption) (SearchResults[Movie], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d/recommendations", movieID), opts...)
+}
+
+func GetMovieSimilar(ctx context.Context, client Client, movieID int32, opts ...RequestOption) (SearchResults[Movie], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d/similar", movieID), opts...)
+}
+
 type Movie Object
 
 func (m Movie) Adult() (bool, error) {
@@ -140,3 +148,47 @@ func (m Movie) ExternalIDs() (ExternalIDs, error) {
 func (m Movie) Images() (Images, error) {
 	return jsonflex.GetField(m, "images", jsonflex.AsObject[Images]())
 }
+
+func (m Movie) Videos() (Videos, error) {
+	return jsonflex.GetField(m, "videos", jsonflex.AsObject[Videos]())
+}
+
+func (m Movie) WatchProviders() (WatchProviders, error) {
+	return jsonflex.GetField(m, "watch/providers", jsonflex.AsObject[WatchProviders]())
+}
+
+func (m Movie) Recommendations() (SearchResults[Movie], error) {
+	return jsonflex.GetField(m, "recommendations", jsonflex.AsObject[SearchResults[Movie]]())
+}
+
+func (m Movie) Similar() (SearchResults[Movie], error) {
+	return jsonflex.GetField(m, "similar", jsonflex.AsObject[SearchResults[Movie]]())
+}
+
+func (m Movie) Reviews() (SearchResults[Review], error) {
+	return jsonflex.GetField(m, "reviews", jsonflex.AsObject[SearchResults[Review]]())
+}
+
+func (m Movie) Translations() (Translations, error) {
+	return jsonflex.GetField(m, "translations", jsonflex.AsObject[Translations]())
+}
+
+func (m Movie) AlternativeTitles() (AlternativeTitles, error) {
+	return jsonflex.GetField(m, "alternative_titles", jsonflex.AsObject[AlternativeTitles]())
+}
+
+func (m Movie) BackdropURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := m.BackdropPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindBackdrop, size, path)
+}
+
+func (m Movie) PosterURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := m.PosterPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindPoster, size, path)
+}
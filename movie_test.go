@@ -2,6 +2,7 @@ package tmdb_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -282,4 +283,60 @@ func findImage(in []tmdb.Image, want string) (tmdb.Image, error) {
 		}
 	}
 	return tmdb.Image{}, fmt.Errorf("no image found with file path: %s", want)
-}
\ No newline at end of file
+}
+
+func TestGetMovieRecommendationsAndSimilar(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/550/recommendations": `{"page":1,"results":[{"id":807,"title":"Se7en","media_type":"movie"}],"total_pages":2,"total_results":40}`,
+		"/3/movie/550/similar":         `{"page":1,"results":[{"id":1578,"title":"Raging Bull"}],"total_pages":500,"total_results":10000}`,
+	})
+	ctx := context.Background()
+
+	if results, err := tmdb.GetMovieRecommendations(ctx, client, 550); err != nil {
+		t.Errorf("GetMovieRecommendations: %v", err)
+	} else {
+		checkField(t, int32(2), results, tmdb.SearchResults[tmdb.Movie].TotalPages)
+		checkField(t, "Se7en", results, tmdb.SearchResults[tmdb.Movie].Results, index(0), tmdb.Movie.Title)
+	}
+	if results, err := tmdb.GetMovieSimilar(ctx, client, 550); err != nil {
+		t.Errorf("GetMovieSimilar: %v", err)
+	} else {
+		checkField(t, int32(1578), results, tmdb.SearchResults[tmdb.Movie].Results, index(0), tmdb.Movie.ID)
+	}
+}
+
+func TestGetMovieAppendedLists(t *testing.T) {
+	client := newFakeClient(t, map[string]string{"/3/movie/550": `{
+		"id": 550,
+		"title": "Fight Club",
+		"recommendations": {"page":1,"results":[{"id":807,"title":"Se7en","media_type":"movie"}],"total_pages":2,"total_results":40},
+		"similar": {"page":1,"results":[{"id":1578,"title":"Raging Bull"}],"total_pages":500,"total_results":10000},
+		"reviews": {"page":1,"results":[` + reviewJSON + `],"total_pages":1,"total_results":1},
+		"videos": {"results":[{"iso_639_1":"en","iso_3166_1":"US","name":"Official Trailer","key":"SUXWAEX2jlg","site":"YouTube","size":1080,"type":"Trailer","official":true,"published_at":"2014-10-02T19:20:22.000Z","id":"v2"}]},
+		"watch/providers": {"results":{"US":{"link":"https://www.themoviedb.org/movie/550-fight-club/watch?locale=US","flatrate":[{"logo_path":"/hulu.jpg","provider_id":15,"provider_name":"Hulu","display_priority":5}]}}}
+	}`})
+	movie, err := tmdb.GetMovie(context.Background(), client, 550,
+		tmdb.WithAppendToResponse("recommendations", "similar", "reviews", "videos", "watch/providers"))
+	if err != nil {
+		t.Fatalf("GetMovie: %v", err)
+	}
+
+	checkField(t, "Se7en", movie, tmdb.Movie.Recommendations, tmdb.SearchResults[tmdb.Movie].Results, index(0), tmdb.Movie.Title)
+	checkField(t, int32(2), movie, tmdb.Movie.Recommendations, tmdb.SearchResults[tmdb.Movie].TotalPages)
+	checkField(t, int32(1578), movie, tmdb.Movie.Similar, tmdb.SearchResults[tmdb.Movie].Results, index(0), tmdb.Movie.ID)
+	checkField(t, "Goddard", movie, tmdb.Movie.Reviews, tmdb.SearchResults[tmdb.Review].Results, index(0), tmdb.Review.Author)
+	checkField(t, "SUXWAEX2jlg", movie, tmdb.Movie.Videos, tmdb.Videos.Results, index(0), tmdb.Video.Key)
+	checkField(t, tmdb.VideoTypeTrailer, movie, tmdb.Movie.Videos, tmdb.Videos.Results, index(0), tmdb.Video.Type)
+	if providers, err := movie.WatchProviders(); err != nil {
+		t.Errorf("WatchProviders: %v", err)
+	} else if us, err := providers.Region("US"); err != nil {
+		t.Errorf("Region(US): %v", err)
+	} else {
+		checkField(t, "https://www.themoviedb.org/movie/550-fight-club/watch?locale=US", us, tmdb.WatchProviderRegion.Link)
+		checkField(t, "Hulu", us, tmdb.WatchProviderRegion.Flatrate, index(0), tmdb.WatchProvider.ProviderName)
+	}
+
+	if _, err := (tmdb.Movie{"id": 550}).WatchProviders(); !errors.Is(err, tmdb.ErrFieldNotFound) {
+		t.Errorf("expected ErrFieldNotFound for watch providers that were not appended, got %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package tmdb
+
+import (
+	"context"
+	"iter"
+)
+
+// MaxPages is the highest page number that TMDB will serve for paginated endpoints.
+const MaxPages = 500
+
+type PageOptions struct {
+	// Stop after this many results.  Zero means no limit.
+	MaxResults int
+	// Fetch the next page in the background while the current page is being consumed.
+	Prefetch bool
+}
+
+// PageFunc fetches a single page of a paginated endpoint.  Page numbers start at 1.
+type PageFunc[T ~Object] func(ctx context.Context, page int32) (SearchResults[T], error)
+
+// AllResults iterates over the results of every page returned by fetch, stopping at the last page, at MaxPages, or at options.MaxResults.
+// If fetching or decoding a page fails, the error is yielded once and iteration stops.
+func AllResults[T ~Object](ctx context.Context, fetch PageFunc[T], options PageOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type pageResult struct {
+			results SearchResults[T]
+			err     error
+		}
+		start := func(page int32, background bool) <-chan pageResult {
+			// Buffered so that an abandoned prefetch never blocks its goroutine.
+			ch := make(chan pageResult, 1)
+			if background {
+				go func() {
+					results, err := fetch(ctx, page)
+					ch <- pageResult{results, err}
+				}()
+			} else {
+				results, err := fetch(ctx, page)
+				ch <- pageResult{results, err}
+			}
+			return ch
+		}
+
+		var zero T
+		yielded := 0
+		next := start(1, false)
+		for page := int32(1); ; page++ {
+			current := <-next
+			if current.err != nil {
+				yield(zero, current.err)
+				return
+			}
+			results, err := current.results.Results()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			totalPages, err := current.results.TotalPages()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			last := len(results) == 0 || page >= min(totalPages, MaxPages) ||
+				(options.MaxResults > 0 && yielded+len(results) >= options.MaxResults)
+			if !last && options.Prefetch {
+				next = start(page+1, true)
+			}
+			for _, result := range results {
+				if options.MaxResults > 0 && yielded >= options.MaxResults {
+					return
+				}
+				if !yield(result, nil) {
+					return
+				}
+				yielded++
+			}
+			if last {
+				return
+			}
+			if !options.Prefetch {
+				next = start(page+1, false)
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+// pagedServer serves /3/search/movie with perPage results on each of totalPages pages.
+type pagedServer struct {
+	*httptest.Server
+	mu    sync.Mutex
+	pages []int
+}
+
+func newPagedServer(t *testing.T, totalPages, perPage int) *pagedServer {
+	t.Helper()
+	s := &pagedServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil {
+			page = 1
+		}
+		s.mu.Lock()
+		s.pages = append(s.pages, page)
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		if page == 3 && totalPages < 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"success":false,"status_code":11,"status_message":"Internal error"}`))
+			return
+		}
+		results := []string{}
+		if page <= totalPages || totalPages < 0 {
+			for i := range perPage {
+				results = append(results, fmt.Sprintf(`{"id":%d}`, page*100+i))
+			}
+		}
+		fmt.Fprintf(w, `{"page":%d,"results":[%s],"total_pages":%d,"total_results":%d}`,
+			page, strings.Join(results, ","), totalPages, totalPages*perPage)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *pagedServer) fetch() tmdb.PageFunc[tmdb.Movie] {
+	client := tmdb.ClientOptions{BaseURL: s.URL}.NewClient()
+	return func(ctx context.Context, page int32) (tmdb.SearchResults[tmdb.Movie], error) {
+		return tmdb.SearchMovie(ctx, client, "alien", tmdb.WithPage(page))
+	}
+}
+
+func (s *pagedServer) requestedPages() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int(nil), s.pages...)
+}
+
+func collectIDs(t *testing.T, seq iter.Seq2[tmdb.Movie, error]) ([]int32, error) {
+	t.Helper()
+	var ids []int32
+	for movie, err := range seq {
+		if err != nil {
+			return ids, err
+		}
+		id, err := movie.ID()
+		if err != nil {
+			t.Fatalf("ID: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func TestAllResults(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		t.Run(fmt.Sprintf("prefetch=%v", prefetch), func(t *testing.T) {
+			server := newPagedServer(t, 3, 2)
+			ids, err := collectIDs(t, tmdb.AllResults(context.Background(), server.fetch(), tmdb.PageOptions{Prefetch: prefetch}))
+			if err != nil {
+				t.Fatalf("AllResults: %v", err)
+			}
+			want := []int32{100, 101, 200, 201, 300, 301}
+			if fmt.Sprint(ids) != fmt.Sprint(want) {
+				t.Errorf("got %v, want %v", ids, want)
+			}
+			if pages := server.requestedPages(); len(pages) != 3 {
+				t.Errorf("expected 3 pages to be requested, got %v", pages)
+			}
+		})
+	}
+}
+
+func TestAllResultsMaxResults(t *testing.T) {
+	server := newPagedServer(t, 10, 2)
+	ids, err := collectIDs(t, tmdb.AllResults(context.Background(), server.fetch(), tmdb.PageOptions{MaxResults: 3, Prefetch: true}))
+	if err != nil {
+		t.Fatalf("AllResults: %v", err)
+	}
+	if want := []int32{100, 101, 200}; fmt.Sprint(ids) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", ids, want)
+	}
+	if pages := server.requestedPages(); len(pages) != 2 {
+		t.Errorf("expected 2 pages to be requested, got %v", pages)
+	}
+}
+
+func TestAllResultsStopsAtMaxPages(t *testing.T) {
+	server := newPagedServer(t, 1000, 1)
+	ids, err := collectIDs(t, tmdb.AllResults(context.Background(), server.fetch(), tmdb.PageOptions{}))
+	if err != nil {
+		t.Fatalf("AllResults: %v", err)
+	}
+	if len(ids) != tmdb.MaxPages {
+		t.Errorf("expected %d results, got %d", tmdb.MaxPages, len(ids))
+	}
+}
+
+func TestAllResultsEarlyBreak(t *testing.T) {
+	server := newPagedServer(t, 5, 2)
+	count := 0
+	for _, err := range tmdb.AllResults(context.Background(), server.fetch(), tmdb.PageOptions{}) {
+		if err != nil {
+			t.Fatalf("AllResults: %v", err)
+		}
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if pages := server.requestedPages(); len(pages) != 1 {
+		t.Errorf("expected only the first page to be requested, got %v", pages)
+	}
+}
+
+func TestAllResultsError(t *testing.T) {
+	// A negative page count makes the server fail on page 3.
+	server := newPagedServer(t, -1, 1)
+	fetch := server.fetch()
+	pages := 0
+	ids, err := collectIDs(t, tmdb.AllResults(context.Background(), func(ctx context.Context, page int32) (tmdb.SearchResults[tmdb.Movie], error) {
+		pages++
+		results, err := fetch(ctx, page)
+		if err == nil {
+			// Pretend there are plenty of pages so that iteration continues.
+			results["total_pages"] = float64(10)
+		}
+		return results, err
+	}, tmdb.PageOptions{}))
+	var apiErr *tmdb.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected 500 APIError, got %v", err)
+	}
+	if len(ids) != 2 || pages != 3 {
+		t.Errorf("expected 2 results from 3 pages, got %v from %d", ids, pages)
+	}
+}
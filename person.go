@@ -0,0 +1,242 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krelinga/go-jsonflex"
+)
+
+const (
+	GenderNotSpecified int32 = 0
+	GenderFemale       int32 = 1
+	GenderMale         int32 = 2
+	GenderNonBinary    int32 = 3
+)
+
+type Person Object
+
+func (p Person) Adult() (bool, error) {
+	return jsonflex.GetField(p, "adult", jsonflex.AsBool())
+}
+
+func (p Person) AlsoKnownAs() ([]string, error) {
+	return jsonflex.GetField(p, "also_known_as", jsonflex.AsArray(jsonflex.AsString()))
+}
+
+func (p Person) Biography() (string, error) {
+	return jsonflex.GetField(p, "biography", jsonflex.AsString())
+}
+
+func (p Person) Birthday() (string, error) {
+	return jsonflex.GetField(p, "birthday", jsonflex.AsString())
+}
+
+func (p Person) Deathday() (string, error) {
+	return jsonflex.GetField(p, "deathday", jsonflex.AsString())
+}
+
+func (p Person) Gender() (int32, error) {
+	return jsonflex.GetField(p, "gender", jsonflex.AsInt32())
+}
+
+func (p Person) Homepage() (string, error) {
+	return jsonflex.GetField(p, "homepage", jsonflex.AsString())
+}
+
+func (p Person) ID() (int32, error) {
+	return jsonflex.GetField(p, "id", jsonflex.AsInt32())
+}
+
+func (p Person) IMDBID() (string, error) {
+	return jsonflex.GetField(p, "imdb_id", jsonflex.AsString())
+}
+
+func (p Person) KnownForDepartment() (string, error) {
+	return jsonflex.GetField(p, "known_for_department", jsonflex.AsString())
+}
+
+func (p Person) Name() (string, error) {
+	return jsonflex.GetField(p, "name", jsonflex.AsString())
+}
+
+func (p Person) PlaceOfBirth() (string, error) {
+	return jsonflex.GetField(p, "place_of_birth", jsonflex.AsString())
+}
+
+func (p Person) Popularity() (float64, error) {
+	return jsonflex.GetField(p, "popularity", jsonflex.AsFloat64())
+}
+
+func (p Person) ProfilePath() (string, error) {
+	return jsonflex.GetField(p, "profile_path", jsonflex.AsString())
+}
+
+// KnownFor is only present in search results.
+func (p Person) KnownFor() ([]MultiResult, error) {
+	return jsonflex.GetField(p, "known_for", jsonflex.AsArray(jsonflex.AsObject[MultiResult]()))
+}
+
+func (p Person) ProfileURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := p.ProfilePath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindProfile, size, path)
+}
+
+func (p Person) MovieCredits() (PersonCredits, error) {
+	return jsonflex.GetField(p, "movie_credits", jsonflex.AsObject[PersonCredits]())
+}
+
+func (p Person) TvCredits() (PersonCredits, error) {
+	return jsonflex.GetField(p, "tv_credits", jsonflex.AsObject[PersonCredits]())
+}
+
+func (p Person) CombinedCredits() (PersonCredits, error) {
+	return jsonflex.GetField(p, "combined_credits", jsonflex.AsObject[PersonCredits]())
+}
+
+func (p Person) Images() (Images, error) {
+	return jsonflex.GetField(p, "images", jsonflex.AsObject[Images]())
+}
+
+func (p Person) ExternalIDs() (ExternalIDs, error) {
+	return jsonflex.GetField(p, "external_ids", jsonflex.AsObject[ExternalIDs]())
+}
+
+func GetPerson(ctx context.Context, client Client, personID int32, opts ...RequestOption) (Person, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/person/%d", personID), opts...)
+}
+
+func GetPersonMovieCredits(ctx context.Context, client Client, personID int32, opts ...RequestOption) (PersonCredits, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/person/%d/movie_credits", personID), opts...)
+}
+
+func GetPersonTvCredits(ctx context.Context, client Client, personID int32, opts ...RequestOption) (PersonCredits, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/person/%d/tv_credits", personID), opts...)
+}
+
+func GetPersonCombinedCredits(ctx context.Context, client Client, personID int32, opts ...RequestOption) (PersonCredits, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/person/%d/combined_credits", personID), opts...)
+}
+
+// PersonCredits lists the movies and shows that a person has worked on.
+type PersonCredits Object
+
+func (pc PersonCredits) ID() (int32, error) {
+	return jsonflex.GetField(pc, "id", jsonflex.AsInt32())
+}
+
+func (pc PersonCredits) Cast() ([]PersonCredit, error) {
+	return jsonflex.GetField(pc, "cast", jsonflex.AsArray(jsonflex.AsObject[PersonCredit]()))
+}
+
+func (pc PersonCredits) Crew() ([]PersonCredit, error) {
+	return jsonflex.GetField(pc, "crew", jsonflex.AsArray(jsonflex.AsObject[PersonCredit]()))
+}
+
+// PersonCredit is a single cast or crew credit of a person.  Movie credits use the title fields, and TV credits use the name fields.
+type PersonCredit Object
+
+func (pc PersonCredit) Adult() (bool, error) {
+	return jsonflex.GetField(pc, "adult", jsonflex.AsBool())
+}
+
+func (pc PersonCredit) BackdropPath() (string, error) {
+	return jsonflex.GetField(pc, "backdrop_path", jsonflex.AsString())
+}
+
+func (pc PersonCredit) GenreIDs() ([]int32, error) {
+	return jsonflex.GetField(pc, "genre_ids", jsonflex.AsArray(jsonflex.AsInt32()))
+}
+
+func (pc PersonCredit) ID() (int32, error) {
+	return jsonflex.GetField(pc, "id", jsonflex.AsInt32())
+}
+
+func (pc PersonCredit) OriginalLanguage() (string, error) {
+	return jsonflex.GetField(pc, "original_language", jsonflex.AsString())
+}
+
+func (pc PersonCredit) OriginalTitle() (string, error) {
+	return jsonflex.GetField(pc, "original_title", jsonflex.AsString())
+}
+
+func (pc PersonCredit) OriginalName() (string, error) {
+	return jsonflex.GetField(pc, "original_name", jsonflex.AsString())
+}
+
+func (pc PersonCredit) OriginCountry() ([]string, error) {
+	return jsonflex.GetField(pc, "origin_country", jsonflex.AsArray(jsonflex.AsString()))
+}
+
+func (pc PersonCredit) Overview() (string, error) {
+	return jsonflex.GetField(pc, "overview", jsonflex.AsString())
+}
+
+func (pc PersonCredit) Popularity() (float64, error) {
+	return jsonflex.GetField(pc, "popularity", jsonflex.AsFloat64())
+}
+
+func (pc PersonCredit) PosterPath() (string, error) {
+	return jsonflex.GetField(pc, "poster_path", jsonflex.AsString())
+}
+
+func (pc PersonCredit) ReleaseDate() (string, error) {
+	return jsonflex.GetField(pc, "release_date", jsonflex.AsString())
+}
+
+func (pc PersonCredit) FirstAirDate() (string, error) {
+	return jsonflex.GetField(pc, "first_air_date", jsonflex.AsString())
+}
+
+func (pc PersonCredit) Title() (string, error) {
+	return jsonflex.GetField(pc, "title", jsonflex.AsString())
+}
+
+func (pc PersonCredit) Name() (string, error) {
+	return jsonflex.GetField(pc, "name", jsonflex.AsString())
+}
+
+func (pc PersonCredit) Video() (bool, error) {
+	return jsonflex.GetField(pc, "video", jsonflex.AsBool())
+}
+
+func (pc PersonCredit) VoteAverage() (float64, error) {
+	return jsonflex.GetField(pc, "vote_average", jsonflex.AsFloat64())
+}
+
+func (pc PersonCredit) VoteCount() (int32, error) {
+	return jsonflex.GetField(pc, "vote_count", jsonflex.AsInt32())
+}
+
+func (pc PersonCredit) Character() (string, error) {
+	return jsonflex.GetField(pc, "character", jsonflex.AsString())
+}
+
+func (pc PersonCredit) CreditID() (string, error) {
+	return jsonflex.GetField(pc, "credit_id", jsonflex.AsString())
+}
+
+func (pc PersonCredit) Order() (int32, error) {
+	return jsonflex.GetField(pc, "order", jsonflex.AsInt32())
+}
+
+func (pc PersonCredit) Department() (string, error) {
+	return jsonflex.GetField(pc, "department", jsonflex.AsString())
+}
+
+func (pc PersonCredit) Job() (string, error) {
+	return jsonflex.GetField(pc, "job", jsonflex.AsString())
+}
+
+// MediaType is only present in combined credits.
+func (pc PersonCredit) MediaType() (MediaType, error) {
+	mediaType, err := jsonflex.GetField(pc, "media_type", jsonflex.AsString())
+	return MediaType(mediaType), err
+}
+
+func (pc PersonCredit) EpisodeCount() (int32, error) {
+	return jsonflex.GetField(pc, "episode_count", jsonflex.AsInt32())
+}
@@ -0,0 +1,154 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+const bradPittJSON = `{
+	"adult": false,
+	"also_known_as": ["William Bradley Pitt", "布拉德·皮特"],
+	"biography": "William Bradley Pitt is an American actor and film producer.",
+	"birthday": "1963-12-18",
+	"deathday": null,
+	"gender": 2,
+	"homepage": null,
+	"id": 287,
+	"imdb_id": "nm0000093",
+	"known_for_department": "Acting",
+	"name": "Brad Pitt",
+	"place_of_birth": "Shawnee, Oklahoma, USA",
+	"popularity": 13.4637,
+	"profile_path": "/cckcYc2v0yh1tc9QjRelptcOBko.jpg",
+	"movie_credits": {
+		"cast": [{
+			"adult": false,
+			"backdrop_path": "/hZkgoQYus5vegHoetLkCJzb17zJ.jpg",
+			"genre_ids": [18],
+			"id": 550,
+			"original_language": "en",
+			"original_title": "Fight Club",
+			"overview": "A ticking-time-bomb insomniac...",
+			"popularity": 24.1745,
+			"poster_path": "/jSziioSwPVrOy9Yow3XhWIBDjq1.jpg",
+			"release_date": "1999-10-15",
+			"title": "Fight Club",
+			"video": false,
+			"vote_average": 8.438,
+			"vote_count": 30717,
+			"character": "Tyler Durden",
+			"credit_id": "52fe4250c3a36847f80149f7",
+			"order": 1
+		}],
+		"crew": [{
+			"id": 550,
+			"title": "Fight Club",
+			"credit_id": "5a3c5d8a0e0a264cb20c6b7e",
+			"department": "Production",
+			"job": "Producer"
+		}]
+	},
+	"tv_credits": {
+		"cast": [{
+			"id": 1668,
+			"name": "Friends",
+			"original_name": "Friends",
+			"first_air_date": "1994-09-22",
+			"origin_country": ["US"],
+			"character": "Will Colbert",
+			"credit_id": "525710b8760ee3776a344d2c",
+			"episode_count": 1
+		}],
+		"crew": []
+	},
+	"combined_credits": {
+		"cast": [{
+			"id": 550,
+			"media_type": "movie",
+			"title": "Fight Club",
+			"release_date": "1999-10-15",
+			"character": "Tyler Durden",
+			"credit_id": "52fe4250c3a36847f80149f7"
+		}, {
+			"id": 1668,
+			"media_type": "tv",
+			"name": "Friends",
+			"first_air_date": "1994-09-22",
+			"character": "Will Colbert",
+			"credit_id": "525710b8760ee3776a344d2c",
+			"episode_count": 1
+		}],
+		"crew": []
+	},
+	"images": {
+		"profiles": [{
+			"aspect_ratio": 0.667,
+			"height": 3000,
+			"iso_639_1": null,
+			"file_path": "/cckcYc2v0yh1tc9QjRelptcOBko.jpg",
+			"vote_average": 5.522,
+			"vote_count": 45,
+			"width": 2000
+		}]
+	},
+	"external_ids": {
+		"imdb_id": "nm0000093",
+		"wikidata_id": "Q35332",
+		"instagram_id": "bradpittofflcial"
+	}
+}`
+
+func TestGetPerson(t *testing.T) {
+	client := newFakeClient(t, map[string]string{"/3/person/287": bradPittJSON})
+	person, err := tmdb.GetPerson(context.Background(), client, 287, tmdb.WithAppendToResponse("movie_credits", "tv_credits", "combined_credits", "images", "external_ids"))
+	if err != nil {
+		t.Fatalf("failed to get person: %v", err)
+	}
+	checkField(t, false, person, tmdb.Person.Adult)
+	checkField(t, "William Bradley Pitt", person, tmdb.Person.AlsoKnownAs, index(0))
+	checkField(t, "William Bradley Pitt is an American actor and film producer.", person, tmdb.Person.Biography)
+	checkField(t, "1963-12-18", person, tmdb.Person.Birthday)
+	if _, err := person.Deathday(); !errors.Is(err, tmdb.ErrNullValue) {
+		t.Errorf("expected ErrNullValue for deathday, got %v", err)
+	}
+	checkField(t, tmdb.GenderMale, person, tmdb.Person.Gender)
+	checkField(t, int32(287), person, tmdb.Person.ID)
+	checkField(t, "nm0000093", person, tmdb.Person.IMDBID)
+	checkField(t, "Acting", person, tmdb.Person.KnownForDepartment)
+	checkField(t, "Brad Pitt", person, tmdb.Person.Name)
+	checkField(t, "Shawnee, Oklahoma, USA", person, tmdb.Person.PlaceOfBirth)
+	checkField(t, 13.4637, person, tmdb.Person.Popularity)
+	checkField(t, "/cckcYc2v0yh1tc9QjRelptcOBko.jpg", person, tmdb.Person.ProfilePath)
+
+	// Movie credits appended to response.
+	checkField(t, int32(550), person, tmdb.Person.MovieCredits, tmdb.PersonCredits.Cast, index(0), tmdb.PersonCredit.ID)
+	checkField(t, "Fight Club", person, tmdb.Person.MovieCredits, tmdb.PersonCredits.Cast, index(0), tmdb.PersonCredit.Title)
+	checkField(t, "1999-10-15", person, tmdb.Person.MovieCredits, tmdb.PersonCredits.Cast, index(0), tmdb.PersonCredit.ReleaseDate)
+	checkField(t, "Tyler Durden", person, tmdb.Person.MovieCredits, tmdb.PersonCredits.Cast, index(0), tmdb.PersonCredit.Character)
+	checkField(t, int32(1), person, tmdb.Person.MovieCredits, tmdb.PersonCredits.Cast, index(0), tmdb.PersonCredit.Order)
+	checkField(t, "Producer", person, tmdb.Person.MovieCredits, tmdb.PersonCredits.Crew, index(0), tmdb.PersonCredit.Job)
+	checkField(t, "Production", person, tmdb.Person.MovieCredits, tmdb.PersonCredits.Crew, index(0), tmdb.PersonCredit.Department)
+
+	// TV credits appended to response.
+	checkField(t, "Friends", person, tmdb.Person.TvCredits, tmdb.PersonCredits.Cast, index(0), tmdb.PersonCredit.Name)
+	checkField(t, "1994-09-22", person, tmdb.Person.TvCredits, tmdb.PersonCredits.Cast, index(0), tmdb.PersonCredit.FirstAirDate)
+	checkField(t, int32(1), person, tmdb.Person.TvCredits, tmdb.PersonCredits.Cast, index(0), tmdb.PersonCredit.EpisodeCount)
+
+	// Combined credits appended to response.
+	checkField(t, tmdb.MediaTypeMovie, person, tmdb.Person.CombinedCredits, tmdb.PersonCredits.Cast, index(0), tmdb.PersonCredit.MediaType)
+	checkField(t, tmdb.MediaTypeTv, person, tmdb.Person.CombinedCredits, tmdb.PersonCredits.Cast, index(1), tmdb.PersonCredit.MediaType)
+	checkField(t, "Will Colbert", person, tmdb.Person.CombinedCredits, tmdb.PersonCredits.Cast, index(1), tmdb.PersonCredit.Character)
+
+	// Images and external IDs appended to response.
+	checkField(t, "/cckcYc2v0yh1tc9QjRelptcOBko.jpg", person, tmdb.Person.Images, tmdb.Images.Profiles, index(0), tmdb.Image.FilePath)
+	checkField(t, int32(2000), person, tmdb.Person.Images, tmdb.Images.Profiles, index(0), tmdb.Image.Width)
+	checkField(t, "Q35332", person, tmdb.Person.ExternalIDs, tmdb.ExternalIDs.WikidataID)
+	checkField(t, "bradpittofflcial", person, tmdb.Person.ExternalIDs, tmdb.ExternalIDs.InstagramID)
+
+	if _, err := (tmdb.Person{"id": 287}).CombinedCredits(); !errors.Is(err, tmdb.ErrFieldNotFound) {
+		t.Errorf("expected ErrFieldNotFound for combined credits that were not appended, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package tmdb
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles requests made by a Client.  A single RateLimiter may be shared by several clients to give them a common budget.
+type RateLimiter interface {
+	// Wait blocks until a request may be sent, or until ctx is done.
+	Wait(ctx context.Context) error
+	// RateLimited is called when TMDB rejects a request with HTTP 429.  retryAfter is zero if the response did not say how long to wait.
+	RateLimited(retryAfter time.Duration)
+}
+
+const (
+	// How far TokenBucket will lower its rate in response to 429s, as a fraction of the configured rate.
+	minRateFraction = 1.0 / 16
+	// How long TokenBucket waits without seeing a 429 before doubling a lowered rate.
+	rateRecoveryPeriod = 10 * time.Second
+)
+
+// TokenBucket is a RateLimiter that allows bursts of up to Burst requests and a sustained rate of Rate requests per second.
+//
+// Each 429 halves the current rate, down to a floor of 1/16 of the configured rate, and pauses all callers until any Retry-After has passed.
+// The rate doubles again for every 10 seconds that pass without a 429, until it is back to the configured rate.
+type TokenBucket struct {
+	mu           sync.Mutex
+	maxRate      float64
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	pausedUntil  time.Time
+	lastThrottle time.Time
+}
+
+// NewTokenBucket returns a TokenBucket that starts full.  rate must be positive, and burst is raised to 1 if it is smaller.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if rate <= 0 {
+		panic("tmdb: NewTokenBucket rate must be positive")
+	}
+	b := float64(max(burst, 1))
+	return &TokenBucket{
+		maxRate: rate,
+		rate:    rate,
+		burst:   b,
+		tokens:  b,
+		last:    time.Now(),
+	}
+}
+
+// Rate returns the current sustained rate in requests per second, which may be lower than the configured rate after 429s.
+func (tb *TokenBucket) Rate() float64 {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	tb.refill(time.Now())
+	return tb.rate
+}
+
+func (tb *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		tb.mu.Lock()
+		now := time.Now()
+		tb.refill(now)
+		var wait time.Duration
+		switch {
+		case now.Before(tb.pausedUntil):
+			wait = tb.pausedUntil.Sub(now)
+		case tb.tokens >= 1:
+			tb.tokens--
+			tb.mu.Unlock()
+			return nil
+		default:
+			wait = time.Duration((1 - tb.tokens) / tb.rate * float64(time.Second))
+		}
+		tb.mu.Unlock()
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+func (tb *TokenBucket) RateLimited(retryAfter time.Duration) {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	now := time.Now()
+	tb.refill(now)
+	tb.rate = max(tb.rate/2, tb.maxRate*minRateFraction)
+	tb.tokens = 0
+	tb.lastThrottle = now
+	if until := now.Add(retryAfter); until.After(tb.pausedUntil) {
+		tb.pausedUntil = until
+	}
+}
+
+// refill adds the tokens accumulated since the last call, and restores the rate if enough time has passed since the last 429.
+// The caller must hold tb.mu.
+func (tb *TokenBucket) refill(now time.Time) {
+	for tb.rate < tb.maxRate && now.Sub(tb.lastThrottle) >= rateRecoveryPeriod {
+		tb.rate = min(tb.rate*2, tb.maxRate)
+		tb.lastThrottle = tb.lastThrottle.Add(rateRecoveryPeriod)
+	}
+	if elapsed := now.Sub(tb.last); elapsed > 0 {
+		tb.tokens = min(tb.tokens+elapsed.Seconds()*tb.rate, tb.burst)
+		tb.last = now
+	}
+}
@@ -0,0 +1,122 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestTokenBucketBurstThenRate(t *testing.T) {
+	tb := tmdb.NewTokenBucket(50, 5)
+	start := time.Now()
+	for range 10 {
+		if err := tb.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait failed: %v", err)
+		}
+	}
+	// 5 tokens are available immediately, and the remaining 5 arrive at 50/s.
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("expected rate limiting to slow down requests, took only %v", elapsed)
+	}
+}
+
+func TestTokenBucketRespectsContext(t *testing.T) {
+	tb := tmdb.NewTokenBucket(0.1, 1)
+	if err := tb.Wait(context.Background()); err != nil {
+		t.Fatalf("first Wait failed: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := tb.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestTokenBucketAdaptsToRateLimiting(t *testing.T) {
+	tb := tmdb.NewTokenBucket(40, 1)
+	tb.RateLimited(0)
+	if got := tb.Rate(); got != 20 {
+		t.Errorf("expected rate to halve to 20, got %v", got)
+	}
+	for range 10 {
+		tb.RateLimited(0)
+	}
+	if got := tb.Rate(); got != 2.5 {
+		t.Errorf("expected rate to bottom out at 2.5, got %v", got)
+	}
+
+	tb = tmdb.NewTokenBucket(1000, 1)
+	tb.RateLimited(50 * time.Millisecond)
+	start := time.Now()
+	if err := tb.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected Wait to pause for Retry-After, took only %v", elapsed)
+	}
+}
+
+func TestTokenBucketSharedAcrossGoroutines(t *testing.T) {
+	tb := tmdb.NewTokenBucket(200, 1)
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 5 {
+				if err := tb.Wait(context.Background()); err != nil {
+					t.Errorf("Wait failed: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	// 20 requests with a burst of 1 need at least 19 refills at 200/s.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected goroutines to share the budget, took only %v", elapsed)
+	}
+}
+
+type recordingLimiter struct {
+	mu          sync.Mutex
+	waits       int
+	retryAfters []time.Duration
+}
+
+func (r *recordingLimiter) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.waits++
+	return nil
+}
+
+func (r *recordingLimiter) RateLimited(retryAfter time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.retryAfters = append(r.retryAfters, retryAfter)
+}
+
+func TestClientUsesRateLimiter(t *testing.T) {
+	server := newScriptedServer(t, http.Header{"Retry-After": {"0"}}, http.StatusTooManyRequests)
+	limiter := &recordingLimiter{}
+	client := tmdb.ClientOptions{
+		BaseURL:     server.URL,
+		Retry:       tmdb.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+		RateLimiter: limiter,
+	}.NewClient()
+	if _, err := tmdb.GetMovie(context.Background(), client, 550); err != nil {
+		t.Fatalf("expected success after retry, got %v", err)
+	}
+	if limiter.waits != 2 {
+		t.Errorf("expected every attempt to wait on the limiter, got %d waits", limiter.waits)
+	}
+	if len(limiter.retryAfters) != 1 || limiter.retryAfters[0] != 0 {
+		t.Errorf("expected one RateLimited(0) call, got %v", limiter.retryAfters)
+	}
+}
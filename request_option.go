@@ -62,3 +62,55 @@ func WithResponseInterceptor(interceptor func(*http.Response)) RequestOption {
 		ChangeResponse: interceptor,
 	}
 }
+
+func WithPage(page int32) RequestOption {
+	return WithQueryParam("page", page)
+}
+
+// Language is an ISO 639-1 code, optionally followed by an ISO 3166-1 region, like "en" or "pt-BR".
+func WithLanguage(language string) RequestOption {
+	return WithQueryParam("language", language)
+}
+
+// Region is an ISO 3166-1 code, like "US".
+func WithRegion(region string) RequestOption {
+	return WithQueryParam("region", region)
+}
+
+func WithIncludeAdult(includeAdult bool) RequestOption {
+	return WithQueryParam("include_adult", includeAdult)
+}
+
+func WithYear(year int32) RequestOption {
+	return WithQueryParam("year", year)
+}
+
+func WithPrimaryReleaseYear(year int32) RequestOption {
+	return WithQueryParam("primary_release_year", year)
+}
+
+func WithFirstAirDateYear(year int32) RequestOption {
+	return WithQueryParam("first_air_date_year", year)
+}
+
+// WithIncludeImageLanguage selects which images to return by ISO 639-1 code.  Use "null" for images with no language.
+func WithIncludeImageLanguage(languages ...string) RequestOption {
+	return withQueryValue("include_image_language", strings.Join(languages, ","))
+}
+
+// WithIncludeVideoLanguage selects which videos to return by ISO 639-1 code.  Use "null" for videos with no language.
+func WithIncludeVideoLanguage(languages ...string) RequestOption {
+	return withQueryValue("include_video_language", strings.Join(languages, ","))
+}
+
+// withQueryValue sets a query parameter to value without escaping it first, so that separators like "," and "|" reach TMDB intact.
+func withQueryValue(key, value string) RequestOption {
+	return RequestOption{
+		ChangeValues: func(values *url.Values) {
+			if *values == nil {
+				*values = url.Values{}
+			}
+			values.Set(key, value)
+		},
+	}
+}
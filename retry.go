@@ -0,0 +1,142 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the client retries failed requests.  The zero value disables retries.
+//
+// Only idempotent requests are retried, and only when the failure was a timeout or temporary network error, an HTTP 429,
+// or an HTTP 5xx.  A Retry-After header on the response takes precedence over the computed backoff, unless it asks for
+// a longer wait than MaxBackoff, in which case the request is not retried.
+type RetryPolicy struct {
+	// Total number of attempts, including the first.  Values less than 2 disable retries.
+	MaxAttempts int
+	// Backoff before the first retry.  Defaults to 500ms.
+	InitialBackoff time.Duration
+	// Upper bound on the wait between attempts.  Defaults to 30s.
+	MaxBackoff time.Duration
+	// Fraction of each backoff, between 0 and 1, that is randomized to spread out retries from concurrent callers.
+	Jitter float64
+}
+
+const (
+	defaultInitialBackoff = 500 * time.Millisecond
+	defaultMaxBackoff     = 30 * time.Second
+)
+
+// backoff returns how long to wait before making the given attempt, where attempt 2 is the first retry.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = defaultInitialBackoff
+	}
+	maxBackoff := p.maxBackoff()
+	delay := initial
+	for i := 2; i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxBackoff)
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		delay -= time.Duration(jitter * rand.Float64() * float64(delay))
+	}
+	return delay
+}
+
+func (p RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return defaultMaxBackoff
+	}
+	return p.MaxBackoff
+}
+
+// shouldRetry reports whether a request that failed with err on the given attempt should be retried, and if so how long to wait first.
+func (p RetryPolicy) shouldRetry(ctx context.Context, req *http.Request, attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || !isIdempotent(req.Method) || ctx.Err() != nil {
+		return 0, false
+	}
+	delay := p.backoff(attempt + 1)
+	var apiErr *APIError
+	switch {
+	case errors.As(err, &apiErr):
+		if apiErr.StatusCode != http.StatusTooManyRequests && apiErr.StatusCode < 500 {
+			return 0, false
+		}
+		if retryAfter, ok := parseRetryAfter(apiErr.Header, time.Now()); ok {
+			if retryAfter > p.maxBackoff() {
+				return 0, false
+			}
+			delay = retryAfter
+		}
+	case isTemporaryNetworkError(err):
+	default:
+		return 0, false
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+		return 0, false
+	}
+	return delay, true
+}
+
+// isTemporaryNetworkError reports whether err is a transport failure that may succeed on another attempt, as opposed to
+// a permanent one like a TLS certificate error or an unknown host.
+func isTemporaryNetworkError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary
+	}
+	return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter decodes a Retry-After header, which may be either a number of seconds or an HTTP date.
+func parseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		return max(when.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
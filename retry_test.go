@@ -0,0 +1,186 @@
+package tmdb_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+// scriptedServer replies to each request with the next status in its script, and with a small JSON object once the script runs out.
+type scriptedServer struct {
+	*httptest.Server
+	calls atomic.Int32
+}
+
+func newScriptedServer(t *testing.T, header http.Header, statuses ...int) *scriptedServer {
+	t.Helper()
+	s := &scriptedServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(s.calls.Add(1))
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		if call <= len(statuses) {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(statuses[call-1])
+			w.Write([]byte(`{"success":false,"status_code":25,"status_message":"scripted failure"}`))
+			return
+		}
+		w.Write([]byte(`{"id":550}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *scriptedServer) client(retry tmdb.RetryPolicy) tmdb.Client {
+	return tmdb.ClientOptions{
+		BaseURL: s.URL,
+		Retry:   retry,
+	}.NewClient()
+}
+
+func TestRetrySucceedsAfterFailures(t *testing.T) {
+	server := newScriptedServer(t, nil, http.StatusBadGateway, http.StatusTooManyRequests)
+	client := server.client(tmdb.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	movie, err := tmdb.GetMovie(context.Background(), client, 550)
+	if err != nil {
+		t.Fatalf("expected success after retries, got %v", err)
+	}
+	checkField(t, int32(550), movie, tmdb.Movie.ID)
+	if got := server.calls.Load(); got != 3 {
+		t.Errorf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	server := newScriptedServer(t, nil, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	client := server.client(tmdb.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond})
+	_, err := tmdb.GetMovie(context.Background(), client, 550)
+	var apiErr *tmdb.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 APIError, got %v", err)
+	}
+	if got := server.calls.Load(); got != 2 {
+		t.Errorf("expected 2 calls, got %d", got)
+	}
+}
+
+func TestRetrySkipsClientErrors(t *testing.T) {
+	server := newScriptedServer(t, nil, http.StatusNotFound)
+	client := server.client(tmdb.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	if _, err := tmdb.GetMovie(context.Background(), client, 550); !errors.Is(err, tmdb.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if got := server.calls.Load(); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestRetryDisabledByDefault(t *testing.T) {
+	server := newScriptedServer(t, nil, http.StatusBadGateway)
+	client := server.client(tmdb.RetryPolicy{})
+	if _, err := tmdb.GetMovie(context.Background(), client, 550); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := server.calls.Load(); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	server := newScriptedServer(t, http.Header{"Retry-After": {"1"}}, http.StatusTooManyRequests)
+	client := server.client(tmdb.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond})
+	start := time.Now()
+	if _, err := tmdb.GetMovie(context.Background(), client, 550); err != nil {
+		t.Fatalf("expected success after retry, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected to wait for Retry-After, only waited %v", elapsed)
+	}
+}
+
+func TestRetryRespectsContextDeadline(t *testing.T) {
+	server := newScriptedServer(t, http.Header{"Retry-After": {"60"}}, http.StatusTooManyRequests)
+	client := server.client(tmdb.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	start := time.Now()
+	if _, err := tmdb.GetMovie(ctx, client, 550); !errors.Is(err, tmdb.ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected to give up immediately when Retry-After exceeds the deadline, waited %v", elapsed)
+	}
+	if got := server.calls.Load(); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestRetryTransportError(t *testing.T) {
+	var calls atomic.Int32
+	client := tmdb.ClientOptions{
+		HttpClient: &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls.Add(1)
+				return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+			}),
+		},
+		Retry: tmdb.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Jitter: 0.5},
+	}.NewClient()
+	if _, err := tmdb.GetMovie(context.Background(), client, 550); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestRetrySkipsPermanentTransportErrors(t *testing.T) {
+	for _, transportErr := range []error{
+		&tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}},
+		&net.DNSError{Err: "no such host", Name: "api.themoviedb.invalid", IsNotFound: true},
+		errors.New("unsupported protocol"),
+	} {
+		var calls atomic.Int32
+		client := tmdb.ClientOptions{
+			HttpClient: &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					calls.Add(1)
+					return nil, transportErr
+				}),
+			},
+			Retry: tmdb.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+		}.NewClient()
+		if _, err := tmdb.GetMovie(context.Background(), client, 550); err == nil {
+			t.Fatalf("%T: expected an error", transportErr)
+		}
+		if got := calls.Load(); got != 1 {
+			t.Errorf("%T: expected 1 call, got %d", transportErr, got)
+		}
+	}
+}
+
+func TestRetryGivesUpWhenRetryAfterExceedsMaxBackoff(t *testing.T) {
+	server := newScriptedServer(t, http.Header{"Retry-After": {"86400"}}, http.StatusTooManyRequests)
+	client := server.client(tmdb.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Minute})
+	start := time.Now()
+	if _, err := tmdb.GetMovie(context.Background(), client, 550); !errors.Is(err, tmdb.ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected to give up immediately, waited %v", elapsed)
+	}
+	if got := server.calls.Load(); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
@@ -0,0 +1,104 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/krelinga/go-jsonflex"
+)
+
+type AuthorDetails Object
+
+func (a AuthorDetails) Name() (string, error) {
+	return jsonflex.GetField(a, "name", jsonflex.AsString())
+}
+
+func (a AuthorDetails) Username() (string, error) {
+	return jsonflex.GetField(a, "username", jsonflex.AsString())
+}
+
+func (a AuthorDetails) AvatarPath() (string, error) {
+	return jsonflex.GetField(a, "avatar_path", jsonflex.AsString())
+}
+
+// Rating is out of 10.  It is null when the author did not rate the title.
+func (a AuthorDetails) Rating() (float64, error) {
+	return jsonflex.GetField(a, "rating", jsonflex.AsFloat64())
+}
+
+// AvatarURL returns the URL of the author's avatar.  Some older avatars are hosted elsewhere, like Gravatar, and are
+// returned as-is, ignoring size.
+func (a AuthorDetails) AvatarURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := a.AvatarPath()
+	if err != nil {
+		return "", err
+	}
+	if external := strings.TrimPrefix(path, "/"); strings.HasPrefix(external, "http://") || strings.HasPrefix(external, "https://") {
+		return external, nil
+	}
+	return b.URL(ImageKindProfile, size, path)
+}
+
+type Review Object
+
+func (r Review) ID() (string, error) {
+	return jsonflex.GetField(r, "id", jsonflex.AsString())
+}
+
+func (r Review) Author() (string, error) {
+	return jsonflex.GetField(r, "author", jsonflex.AsString())
+}
+
+func (r Review) AuthorDetails() (AuthorDetails, error) {
+	return jsonflex.GetField(r, "author_details", jsonflex.AsObject[AuthorDetails]())
+}
+
+func (r Review) Content() (string, error) {
+	return jsonflex.GetField(r, "content", jsonflex.AsString())
+}
+
+func (r Review) CreatedAt() (time.Time, error) {
+	return jsonflex.GetField(r, "created_at", asTime())
+}
+
+func (r Review) UpdatedAt() (time.Time, error) {
+	return jsonflex.GetField(r, "updated_at", asTime())
+}
+
+func (r Review) URL() (string, error) {
+	return jsonflex.GetField(r, "url", jsonflex.AsString())
+}
+
+// The fields below are only present in reviews from GetReview.
+
+func (r Review) ISO639_1() (string, error) {
+	return jsonflex.GetField(r, "iso_639_1", jsonflex.AsString())
+}
+
+func (r Review) MediaID() (int32, error) {
+	return jsonflex.GetField(r, "media_id", jsonflex.AsInt32())
+}
+
+func (r Review) MediaTitle() (string, error) {
+	return jsonflex.GetField(r, "media_title", jsonflex.AsString())
+}
+
+func (r Review) MediaType() (MediaType, error) {
+	mediaType, err := jsonflex.GetField(r, "media_type", jsonflex.AsString())
+	return MediaType(mediaType), err
+}
+
+func GetMovieReviews(ctx context.Context, client Client, movieID int32, opts ...RequestOption) (SearchResults[Review], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d/reviews", movieID), opts...)
+}
+
+func GetShowReviews(ctx context.Context, client Client, showID int32, opts ...RequestOption) (SearchResults[Review], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/reviews", showID), opts...)
+}
+
+func GetReview(ctx context.Context, client Client, reviewID string, opts ...RequestOption) (Review, error) {
+	return client.GetObject(ctx, "/3/review/"+url.PathEscape(reviewID), opts...)
+}
@@ -0,0 +1,84 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+const reviewJSON = `{
+	"author":"Goddard",
+	"author_details":{"name":"","username":"Goddard","avatar_path":"/https://secure.gravatar.com/avatar/f248.jpg","rating":null},
+	"content":"Pretty awesome movie.",
+	"created_at":"2018-06-09T17:51:53.359Z",
+	"id":"5b1c13b9c3a36848f2026384",
+	"updated_at":"2021-06-23T15:58:09.421Z",
+	"url":"https://www.themoviedb.org/review/5b1c13b9c3a36848f2026384"
+}`
+
+func TestGetReviews(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/550/reviews":               `{"id":550,"page":1,"results":[` + reviewJSON + `],"total_pages":1,"total_results":1}`,
+		"/3/tv/1399/reviews":                 `{"id":1399,"page":1,"results":[{"author":"Lovelyn","author_details":{"name":"Lovelyn","username":"lovelyn","avatar_path":"/avatar.jpg","rating":9.0},"content":"Great.","created_at":"2023-01-01T00:00:00.000Z","id":"abc","updated_at":"2023-01-02T00:00:00.000Z","url":"https://www.themoviedb.org/review/abc"}],"total_pages":1,"total_results":1}`,
+		"/3/review/5b1c13b9c3a36848f2026384": `{"id":"5b1c13b9c3a36848f2026384","author":"Goddard","author_details":{"name":"","username":"Goddard","avatar_path":null,"rating":null},"content":"Pretty awesome movie.","created_at":"2018-06-09T17:51:53.359Z","iso_639_1":"en","media_id":550,"media_title":"Fight Club","media_type":"movie","updated_at":"2021-06-23T15:58:09.421Z","url":"https://www.themoviedb.org/review/5b1c13b9c3a36848f2026384"}`,
+	})
+	ctx := context.Background()
+
+	if reviews, err := tmdb.GetMovieReviews(ctx, client, 550); err != nil {
+		t.Errorf("GetMovieReviews: %v", err)
+	} else {
+		checkField(t, "Goddard", reviews, tmdb.SearchResults[tmdb.Review].Results, index(0), tmdb.Review.Author)
+		checkField(t, "Pretty awesome movie.", reviews, tmdb.SearchResults[tmdb.Review].Results, index(0), tmdb.Review.Content)
+		checkField(t, time.Date(2018, 6, 9, 17, 51, 53, 359000000, time.UTC), reviews, tmdb.SearchResults[tmdb.Review].Results, index(0), tmdb.Review.CreatedAt)
+		checkField(t, time.Date(2021, 6, 23, 15, 58, 9, 421000000, time.UTC), reviews, tmdb.SearchResults[tmdb.Review].Results, index(0), tmdb.Review.UpdatedAt)
+		checkField(t, "Goddard", reviews, tmdb.SearchResults[tmdb.Review].Results, index(0), tmdb.Review.AuthorDetails, tmdb.AuthorDetails.Username)
+	}
+	if reviews, err := tmdb.GetShowReviews(ctx, client, 1399); err != nil {
+		t.Errorf("GetShowReviews: %v", err)
+	} else {
+		checkField(t, 9.0, reviews, tmdb.SearchResults[tmdb.Review].Results, index(0), tmdb.Review.AuthorDetails, tmdb.AuthorDetails.Rating)
+		checkField(t, "https://www.themoviedb.org/review/abc", reviews, tmdb.SearchResults[tmdb.Review].Results, index(0), tmdb.Review.URL)
+	}
+	if review, err := tmdb.GetReview(ctx, client, "5b1c13b9c3a36848f2026384"); err != nil {
+		t.Errorf("GetReview: %v", err)
+	} else {
+		checkField(t, "Fight Club", review, tmdb.Review.MediaTitle)
+		checkField(t, int32(550), review, tmdb.Review.MediaID)
+		checkField(t, tmdb.MediaTypeMovie, review, tmdb.Review.MediaType)
+		checkField(t, "en", review, tmdb.Review.ISO639_1)
+		if details, err := review.AuthorDetails(); err != nil {
+			t.Errorf("AuthorDetails: %v", err)
+		} else if _, err := details.Rating(); !errors.Is(err, tmdb.ErrNullValue) {
+			t.Errorf("expected ErrNullValue for a missing rating, got %v", err)
+		}
+	}
+}
+
+func TestReviewTimes(t *testing.T) {
+	review := tmdb.Review{"created_at": "2018-06-09T17:51:53.359Z", "updated_at": "yesterday"}
+	checkField(t, time.Date(2018, 6, 9, 17, 51, 53, 359000000, time.UTC), review, tmdb.Review.CreatedAt)
+	if _, err := review.UpdatedAt(); !errors.Is(err, tmdb.ErrCannotConvert) {
+		t.Errorf("expected ErrCannotConvert for a malformed time, got %v", err)
+	}
+	if _, err := (tmdb.Review{"created_at": nil}).CreatedAt(); !errors.Is(err, tmdb.ErrNullValue) {
+		t.Errorf("expected ErrNullValue for a null time, got %v", err)
+	}
+}
+
+func TestAvatarURL(t *testing.T) {
+	b := newTestImageURLBuilder(t)
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/avatar.jpg", "https://image.tmdb.org/t/p/w45/avatar.jpg"},
+		{"/https://secure.gravatar.com/avatar/f248.jpg", "https://secure.gravatar.com/avatar/f248.jpg"},
+	}
+	for _, tt := range tests {
+		details := tmdb.AuthorDetails{"avatar_path": tt.path}
+		checkField(t, tt.want, details, func(a tmdb.AuthorDetails) (string, error) { return a.AvatarURL(b, "w45") })
+	}
+}
@@ -2,6 +2,7 @@ package tmdb
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/krelinga/go-jsonflex"
 )
@@ -33,3 +34,54 @@ func SearchTv(ctx context.Context, client Client, query string, opts ...RequestO
 	opts = append([]RequestOption{WithQueryParam("query", query)}, opts...)
 	return client.GetObject(ctx, "/3/search/tv", opts...)
 }
+
+func SearchMulti(ctx context.Context, client Client, query string, opts ...RequestOption) (SearchResults[MultiResult], error) {
+	opts = append([]RequestOption{WithQueryParam("query", query)}, opts...)
+	return client.GetObject(ctx, "/3/search/multi", opts...)
+}
+
+func SearchPerson(ctx context.Context, client Client, query string, opts ...RequestOption) (SearchResults[Person], error) {
+	opts = append([]RequestOption{WithQueryParam("query", query)}, opts...)
+	return client.GetObject(ctx, "/3/search/person", opts...)
+}
+
+func SearchCompany(ctx context.Context, client Client, query string, opts ...RequestOption) (SearchResults[Company], error) {
+	opts = append([]RequestOption{WithQueryParam("query", query)}, opts...)
+	return client.GetObject(ctx, "/3/search/company", opts...)
+}
+
+func SearchCollection(ctx context.Context, client Client, query string, opts ...RequestOption) (SearchResults[Collection], error) {
+	opts = append([]RequestOption{WithQueryParam("query", query)}, opts...)
+	return client.GetObject(ctx, "/3/search/collection", opts...)
+}
+
+func SearchKeyword(ctx context.Context, client Client, query string, opts ...RequestOption) (SearchResults[Keyword], error) {
+	opts = append([]RequestOption{WithQueryParam("query", query)}, opts...)
+	return client.GetObject(ctx, "/3/search/keyword", opts...)
+}
+
+// MultiResult is a result from an endpoint that mixes movies, shows, and people.
+type MultiResult Object
+
+func (m MultiResult) MediaType() (MediaType, error) {
+	mediaType, err := jsonflex.GetField(m, "media_type", jsonflex.AsString())
+	return MediaType(mediaType), err
+}
+
+// Media returns the result as a Movie, Show, or Person, according to its media type.
+func (m MultiResult) Media() (any, error) {
+	mediaType, err := m.MediaType()
+	if err != nil {
+		return nil, err
+	}
+	switch mediaType {
+	case MediaTypeMovie:
+		return Movie(m), nil
+	case MediaTypeTv:
+		return Show(m), nil
+	case MediaTypePerson:
+		return Person(m), nil
+	default:
+		return nil, fmt.Errorf("unknown media type %q", mediaType)
+	}
+}
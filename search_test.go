@@ -2,7 +2,12 @@ package tmdb_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"slices"
 	"testing"
 
@@ -117,3 +122,131 @@ func findShow(shows []tmdb.Show, name string) (tmdb.Show, error) {
 	}
 	return nil, fmt.Errorf("show not found: %s", name)
 }
+
+func TestSearchMulti(t *testing.T) {
+	client := newFakeClient(t, map[string]string{"/3/search/multi": `{
+		"page": 1,
+		"results": [
+			{"id": 550, "media_type": "movie", "title": "Fight Club"},
+			{"id": 1396, "media_type": "tv", "name": "Breaking Bad"},
+			{"id": 287, "media_type": "person", "name": "Brad Pitt", "known_for": [{"id": 550, "media_type": "movie", "title": "Fight Club"}]}
+		],
+		"total_pages": 1,
+		"total_results": 3
+	}`})
+	results, err := tmdb.SearchMulti(context.Background(), client, "fight")
+	if err != nil {
+		t.Fatalf("Failed to search multi: %v", err)
+	}
+	items, err := results.Results()
+	if err != nil {
+		t.Fatalf("Failed to get multi results: %v", err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(items))
+	}
+	for i, want := range []string{"Fight Club", "Breaking Bad", "Brad Pitt"} {
+		media, err := items[i].Media()
+		if err != nil {
+			t.Fatalf("result %d: %v", i, err)
+		}
+		var got string
+		switch m := media.(type) {
+		case tmdb.Movie:
+			got, err = m.Title()
+		case tmdb.Show:
+			got, err = m.Name()
+		case tmdb.Person:
+			got, err = m.Name()
+			checkField(t, tmdb.MediaTypeMovie, m, tmdb.Person.KnownFor, index(0), tmdb.MultiResult.MediaType)
+		}
+		if err != nil || got != want {
+			t.Errorf("result %d: got %q, %v, want %q", i, got, err, want)
+		}
+	}
+}
+
+func TestMultiResultMedia(t *testing.T) {
+	if _, err := (tmdb.MultiResult{"id": 1, "media_type": "collection"}).Media(); err == nil {
+		t.Error("expected an error for an unknown media type")
+	}
+	if _, err := (tmdb.MultiResult{"id": 1}).Media(); !errors.Is(err, tmdb.ErrFieldNotFound) {
+		t.Errorf("expected ErrFieldNotFound for a missing media type, got %v", err)
+	}
+	if media, err := (tmdb.MultiResult{"id": 287, "media_type": "person"}).Media(); err != nil {
+		t.Errorf("Media: %v", err)
+	} else if _, ok := media.(tmdb.Person); !ok {
+		t.Errorf("expected a Person, got %T", media)
+	}
+}
+
+func TestSearchOtherTypes(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/search/person":     `{"page":1,"results":[{"id":287,"name":"Brad Pitt"}],"total_pages":1,"total_results":1}`,
+		"/3/search/company":    `{"page":1,"results":[{"id":711,"name":"Fox 2000 Pictures","logo_path":"/tEiIH5QesdheJmDAqQwvtN60727.png","origin_country":"US"}],"total_pages":1,"total_results":1}`,
+		"/3/search/collection": `{"page":1,"results":[{"id":8091,"name":"Alien Collection"}],"total_pages":1,"total_results":1}`,
+		"/3/search/keyword":    `{"page":1,"results":[{"id":825,"name":"support group"}],"total_pages":1,"total_results":1}`,
+	})
+	ctx := context.Background()
+	if results, err := tmdb.SearchPerson(ctx, client, "brad pitt"); err != nil {
+		t.Errorf("Failed to search person: %v", err)
+	} else {
+		checkField(t, "Brad Pitt", results, tmdb.SearchResults[tmdb.Person].Results, index(0), tmdb.Person.Name)
+	}
+	if results, err := tmdb.SearchCompany(ctx, client, "fox"); err != nil {
+		t.Errorf("Failed to search company: %v", err)
+	} else {
+		checkField(t, "Fox 2000 Pictures", results, tmdb.SearchResults[tmdb.Company].Results, index(0), tmdb.Company.Name)
+	}
+	if results, err := tmdb.SearchCollection(ctx, client, "alien"); err != nil {
+		t.Errorf("Failed to search collection: %v", err)
+	} else {
+		checkField(t, int32(8091), results, tmdb.SearchResults[tmdb.Collection].Results, index(0), tmdb.Collection.ID)
+	}
+	if results, err := tmdb.SearchKeyword(ctx, client, "support"); err != nil {
+		t.Errorf("Failed to search keyword: %v", err)
+	} else {
+		checkField(t, "support group", results, tmdb.SearchResults[tmdb.Keyword].Results, index(0), tmdb.Keyword.Name)
+	}
+}
+
+func TestSearchParams(t *testing.T) {
+	var got url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"page":1,"results":[],"total_pages":0,"total_results":0}`))
+	}))
+	defer server.Close()
+	client := tmdb.ClientOptions{BaseURL: server.URL}.NewClient()
+
+	_, err := tmdb.SearchMovie(context.Background(), client, "alien",
+		tmdb.WithYear(1979),
+		tmdb.WithPrimaryReleaseYear(1979),
+		tmdb.WithIncludeAdult(false),
+		tmdb.WithRegion("US"),
+		tmdb.WithLanguage("en-US"),
+		tmdb.WithPage(2))
+	if err != nil {
+		t.Fatalf("Failed to search movie: %v", err)
+	}
+	want := url.Values{
+		"query":                {"alien"},
+		"year":                 {"1979"},
+		"primary_release_year": {"1979"},
+		"include_adult":        {"false"},
+		"region":               {"US"},
+		"language":             {"en-US"},
+		"page":                 {"2"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("query: got %v, want %v", got, want)
+	}
+
+	if _, err := tmdb.SearchTv(context.Background(), client, "lost", tmdb.WithFirstAirDateYear(2004)); err != nil {
+		t.Fatalf("Failed to search TV: %v", err)
+	}
+	if got.Get("first_air_date_year") != "2004" {
+		t.Errorf("first_air_date_year: got %q, want %q", got.Get("first_air_date_year"), "2004")
+	}
+}
@@ -53,6 +53,22 @@ func (s Season) ShowID() (int32, error) {
 	return jsonflex.GetField(s, "show_id", jsonflex.AsInt32())
 }
 
+func (s Season) ExternalIDs() (ExternalIDs, error) {
+	return jsonflex.GetField(s, "external_ids", jsonflex.AsObject[ExternalIDs]())
+}
+
+func (s Season) Images() (Images, error) {
+	return jsonflex.GetField(s, "images", jsonflex.AsObject[Images]())
+}
+
+func (s Season) PosterURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := s.PosterPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindPoster, size, path)
+}
+
 func GetSeason(ctx context.Context, client Client, showID, seasonNumber int32, opts ...RequestOption) (Season, error) {
 	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d", showID, seasonNumber), opts...)
 }
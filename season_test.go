@@ -77,6 +77,11 @@ func TestGetSeason(t *testing.T) {
 	checkField(t, "/wgfKiqzuMrFIkU1M68DDDY8kGC1.jpg", season, tmdb.Season.PosterPath)
 	checkField(t, int32(1), season, tmdb.Season.SeasonNumber)
 	checkField(t, 8.4, season, tmdb.Season.VoteAverage)
+
+	// External IDs appended to response.
+	checkField(t, "/m/0gmd1gd", season, tmdb.Season.ExternalIDs, tmdb.ExternalIDs.FreebaseMID)
+	checkField(t, int32(364731), season, tmdb.Season.ExternalIDs, tmdb.ExternalIDs.TVDBID)
+	checkField(t, "Q1658029", season, tmdb.Season.ExternalIDs, tmdb.ExternalIDs.WikidataID)
 }
 
 func findEpisode(episodes []tmdb.Episode, number int32) (tmdb.Episode, error) {
@@ -153,10 +153,66 @@ func (s Show) ExternalIDs() (ExternalIDs, error) {
 	return jsonflex.GetField(s, "external_ids", jsonflex.AsObject[ExternalIDs]())
 }
 
+func (s Show) Images() (Images, error) {
+	return jsonflex.GetField(s, "images", jsonflex.AsObject[Images]())
+}
+
+func (s Show) Videos() (Videos, error) {
+	return jsonflex.GetField(s, "videos", jsonflex.AsObject[Videos]())
+}
+
+func (s Show) WatchProviders() (WatchProviders, error) {
+	return jsonflex.GetField(s, "watch/providers", jsonflex.AsObject[WatchProviders]())
+}
+
+func (s Show) Recommendations() (SearchResults[Show], error) {
+	return jsonflex.GetField(s, "recommendations", jsonflex.AsObject[SearchResults[Show]]())
+}
+
+func (s Show) Similar() (SearchResults[Show], error) {
+	return jsonflex.GetField(s, "similar", jsonflex.AsObject[SearchResults[Show]]())
+}
+
+func (s Show) Reviews() (SearchResults[Review], error) {
+	return jsonflex.GetField(s, "reviews", jsonflex.AsObject[SearchResults[Review]]())
+}
+
+func (s Show) Translations() (Translations, error) {
+	return jsonflex.GetField(s, "translations", jsonflex.AsObject[Translations]())
+}
+
+func (s Show) AlternativeTitles() (AlternativeTitles, error) {
+	return jsonflex.GetField(s, "alternative_titles", jsonflex.AsObject[AlternativeTitles]())
+}
+
 func (s Show) Keywords() (Keywords, error) {
 	return jsonflex.GetField(s, "keywords", jsonflex.AsObject[Keywords]())
 }
 
+func (s Show) BackdropURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := s.BackdropPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindBackdrop, size, path)
+}
+
+func (s Show) PosterURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := s.PosterPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindPoster, size, path)
+}
+
 func GetShow(ctx context.Context, client Client, showId int32, opts ...RequestOption) (Show, error) {
 	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d", showId), opts...)
 }
+
+func GetShowRecommendations(ctx context.Context, client Client, showID int32, opts ...RequestOption) (SearchResults[Show], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/recommendations", showID), opts...)
+}
+
+func GetShowSimilar(ctx context.Context, client Client, showID int32, opts ...RequestOption) (SearchResults[Show], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/similar", showID), opts...)
+}
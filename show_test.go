@@ -2,6 +2,7 @@ package tmdb_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -421,3 +422,56 @@ func findContentRating(in []tmdb.ContentRating, want string) (tmdb.ContentRating
 	}
 	return tmdb.ContentRating{}, fmt.Errorf("no content rating found with iso_3166_1: %s", want)
 }
+
+func TestGetShowRecommendationsAndSimilar(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/tv/1399/recommendations": `{"page":1,"results":[{"id":1402,"name":"The Walking Dead","media_type":"tv"}],"total_pages":2,"total_results":40}`,
+		"/3/tv/1399/similar":         `{"page":1,"results":[{"id":94997,"name":"House of the Dragon"}],"total_pages":1,"total_results":1}`,
+	})
+	ctx := context.Background()
+
+	if results, err := tmdb.GetShowRecommendations(ctx, client, 1399); err != nil {
+		t.Errorf("GetShowRecommendations: %v", err)
+	} else {
+		checkField(t, int32(40), results, tmdb.SearchResults[tmdb.Show].TotalResults)
+		checkField(t, "The Walking Dead", results, tmdb.SearchResults[tmdb.Show].Results, index(0), tmdb.Show.Name)
+	}
+	if results, err := tmdb.GetShowSimilar(ctx, client, 1399); err != nil {
+		t.Errorf("GetShowSimilar: %v", err)
+	} else {
+		checkField(t, int32(94997), results, tmdb.SearchResults[tmdb.Show].Results, index(0), tmdb.Show.ID)
+	}
+}
+
+func TestGetShowAppendedLists(t *testing.T) {
+	client := newFakeClient(t, map[string]string{"/3/tv/1399": `{
+		"id": 1399,
+		"name": "Game of Thrones",
+		"recommendations": {"page":1,"results":[{"id":1402,"name":"The Walking Dead","media_type":"tv"}],"total_pages":2,"total_results":40},
+		"similar": {"page":1,"results":[{"id":94997,"name":"House of the Dragon"}],"total_pages":1,"total_results":1},
+		"reviews": {"page":1,"results":[{"author":"Lovelyn","author_details":{"name":"Lovelyn","username":"lovelyn","avatar_path":"/avatar.jpg","rating":9.0},"content":"Great.","created_at":"2023-01-01T00:00:00.000Z","id":"abc","updated_at":"2023-01-02T00:00:00.000Z","url":"https://www.themoviedb.org/review/abc"}],"total_pages":1,"total_results":1},
+		"videos": {"results":[{"key":"got","site":"YouTube","type":"Trailer","official":true}]},
+		"watch/providers": {"results":{"US":{"link":"https://www.themoviedb.org/tv/1399-game-of-thrones/watch?locale=US","flatrate":[{"logo_path":"/max.jpg","provider_id":1899,"provider_name":"Max","display_priority":3}]}}}
+	}`})
+	show, err := tmdb.GetShow(context.Background(), client, 1399,
+		tmdb.WithAppendToResponse("recommendations", "similar", "reviews", "videos", "watch/providers"))
+	if err != nil {
+		t.Fatalf("GetShow: %v", err)
+	}
+
+	checkField(t, "The Walking Dead", show, tmdb.Show.Recommendations, tmdb.SearchResults[tmdb.Show].Results, index(0), tmdb.Show.Name)
+	checkField(t, int32(94997), show, tmdb.Show.Similar, tmdb.SearchResults[tmdb.Show].Results, index(0), tmdb.Show.ID)
+	checkField(t, 9.0, show, tmdb.Show.Reviews, tmdb.SearchResults[tmdb.Review].Results, index(0), tmdb.Review.AuthorDetails, tmdb.AuthorDetails.Rating)
+	checkField(t, "got", show, tmdb.Show.Videos, tmdb.Videos.Results, index(0), tmdb.Video.Key)
+	if providers, err := show.WatchProviders(); err != nil {
+		t.Errorf("WatchProviders: %v", err)
+	} else if us, err := providers.Region("US"); err != nil {
+		t.Errorf("Region(US): %v", err)
+	} else {
+		checkField(t, "Max", us, tmdb.WatchProviderRegion.Flatrate, index(0), tmdb.WatchProvider.ProviderName)
+	}
+
+	if _, err := (tmdb.Show{"id": 1399}).WatchProviders(); !errors.Is(err, tmdb.ErrFieldNotFound) {
+		t.Errorf("expected ErrFieldNotFound for watch providers that were not appended, got %v", err)
+	}
+}
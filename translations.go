@@ -0,0 +1,97 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krelinga/go-jsonflex"
+)
+
+// TranslationData holds the translated fields.  Which fields are present depends on what was translated: movies and
+// collections have a title, shows, seasons and episodes have a name, and people have a biography.
+type TranslationData Object
+
+func (t TranslationData) Biography() (string, error) {
+	return jsonflex.GetField(t, "biography", jsonflex.AsString())
+}
+
+func (t TranslationData) Homepage() (string, error) {
+	return jsonflex.GetField(t, "homepage", jsonflex.AsString())
+}
+
+func (t TranslationData) Name() (string, error) {
+	return jsonflex.GetField(t, "name", jsonflex.AsString())
+}
+
+func (t TranslationData) Overview() (string, error) {
+	return jsonflex.GetField(t, "overview", jsonflex.AsString())
+}
+
+func (t TranslationData) Runtime() (int32, error) {
+	return jsonflex.GetField(t, "runtime", jsonflex.AsInt32())
+}
+
+func (t TranslationData) Tagline() (string, error) {
+	return jsonflex.GetField(t, "tagline", jsonflex.AsString())
+}
+
+func (t TranslationData) Title() (string, error) {
+	return jsonflex.GetField(t, "title", jsonflex.AsString())
+}
+
+type Translation Object
+
+func (t Translation) ISO3166_1() (string, error) {
+	return jsonflex.GetField(t, "iso_3166_1", jsonflex.AsString())
+}
+
+func (t Translation) ISO639_1() (string, error) {
+	return jsonflex.GetField(t, "iso_639_1", jsonflex.AsString())
+}
+
+// Name is the name of the language in that language, like "Deutsch".
+func (t Translation) Name() (string, error) {
+	return jsonflex.GetField(t, "name", jsonflex.AsString())
+}
+
+func (t Translation) EnglishName() (string, error) {
+	return jsonflex.GetField(t, "english_name", jsonflex.AsString())
+}
+
+func (t Translation) Data() (TranslationData, error) {
+	return jsonflex.GetField(t, "data", jsonflex.AsObject[TranslationData]())
+}
+
+type Translations Object
+
+func (t Translations) ID() (int32, error) {
+	return jsonflex.GetField(t, "id", jsonflex.AsInt32())
+}
+
+func (t Translations) Translations() ([]Translation, error) {
+	return jsonflex.GetField(t, "translations", jsonflex.AsArray(jsonflex.AsObject[Translation]()))
+}
+
+func GetMovieTranslations(ctx context.Context, client Client, movieID int32, opts ...RequestOption) (Translations, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d/translations", movieID), opts...)
+}
+
+func GetShowTranslations(ctx context.Context, client Client, showID int32, opts ...RequestOption) (Translations, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/translations", showID), opts...)
+}
+
+func GetSeasonTranslations(ctx context.Context, client Client, showID, seasonNumber int32, opts ...RequestOption) (Translations, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d/translations", showID, seasonNumber), opts...)
+}
+
+func GetEpisodeTranslations(ctx context.Context, client Client, showID, seasonNumber, episodeNumber int32, opts ...RequestOption) (Translations, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d/episode/%d/translations", showID, seasonNumber, episodeNumber), opts...)
+}
+
+func GetPersonTranslations(ctx context.Context, client Client, personID int32, opts ...RequestOption) (Translations, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/person/%d/translations", personID), opts...)
+}
+
+func GetCollectionTranslations(ctx context.Context, client Client, collectionID int32, opts ...RequestOption) (Translations, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/collection/%d/translations", collectionID), opts...)
+}
@@ -0,0 +1,59 @@
+package tmdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestGetTranslations(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/550/translations":                  `{"id":550,"translations":[{"iso_3166_1":"DE","iso_639_1":"de","name":"Deutsch","english_name":"German","data":{"homepage":"","overview":"Ein Yuppie...","runtime":139,"tagline":"Mischief. Mayhem. Seife.","title":"Fight Club"}}]}`,
+		"/3/tv/1399/translations":                    `{"id":1399,"translations":[{"iso_3166_1":"BR","iso_639_1":"pt","name":"Português","english_name":"Portuguese","data":{"name":"Game of Thrones","overview":"Sete famílias...","homepage":"https://www.hbo.com/game-of-thrones","tagline":"O inverno está chegando."}}]}`,
+		"/3/tv/1399/season/1/translations":           `{"id":3624,"translations":[{"iso_3166_1":"FR","iso_639_1":"fr","name":"Français","english_name":"French","data":{"name":"Saison 1","overview":""}}]}`,
+		"/3/tv/1399/season/1/episode/1/translations": `{"id":63056,"translations":[{"iso_3166_1":"ES","iso_639_1":"es","name":"Español","english_name":"Spanish","data":{"name":"Se acerca el invierno","overview":"..."}}]}`,
+		"/3/person/287/translations":                 `{"id":287,"translations":[{"iso_3166_1":"IT","iso_639_1":"it","name":"Italiano","english_name":"Italian","data":{"biography":"William Bradley Pitt..."}}]}`,
+		"/3/collection/10/translations":              `{"id":10,"translations":[{"iso_3166_1":"JP","iso_639_1":"ja","name":"日本語","english_name":"Japanese","data":{"title":"スター・ウォーズ シリーズ","overview":"","homepage":""}}]}`,
+	})
+	ctx := context.Background()
+
+	if translations, err := tmdb.GetMovieTranslations(ctx, client, 550); err != nil {
+		t.Errorf("GetMovieTranslations: %v", err)
+	} else {
+		checkField(t, int32(550), translations, tmdb.Translations.ID)
+		checkField(t, "DE", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.ISO3166_1)
+		checkField(t, "de", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.ISO639_1)
+		checkField(t, "Deutsch", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Name)
+		checkField(t, "German", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.EnglishName)
+		checkField(t, "Mischief. Mayhem. Seife.", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Tagline)
+		checkField(t, int32(139), translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Runtime)
+		checkField(t, "Fight Club", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Title)
+	}
+	if translations, err := tmdb.GetShowTranslations(ctx, client, 1399); err != nil {
+		t.Errorf("GetShowTranslations: %v", err)
+	} else {
+		checkField(t, "O inverno está chegando.", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Tagline)
+		checkField(t, "https://www.hbo.com/game-of-thrones", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Homepage)
+	}
+	if translations, err := tmdb.GetSeasonTranslations(ctx, client, 1399, 1); err != nil {
+		t.Errorf("GetSeasonTranslations: %v", err)
+	} else {
+		checkField(t, "Saison 1", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Name)
+	}
+	if translations, err := tmdb.GetEpisodeTranslations(ctx, client, 1399, 1, 1); err != nil {
+		t.Errorf("GetEpisodeTranslations: %v", err)
+	} else {
+		checkField(t, "Se acerca el invierno", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Name)
+	}
+	if translations, err := tmdb.GetPersonTranslations(ctx, client, 287); err != nil {
+		t.Errorf("GetPersonTranslations: %v", err)
+	} else {
+		checkField(t, "William Bradley Pitt...", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Biography)
+	}
+	if translations, err := tmdb.GetCollectionTranslations(ctx, client, 10); err != nil {
+		t.Errorf("GetCollectionTranslations: %v", err)
+	} else {
+		checkField(t, "スター・ウォーズ シリーズ", translations, tmdb.Translations.Translations, index(0), tmdb.Translation.Data, tmdb.TranslationData.Title)
+	}
+}
@@ -0,0 +1,30 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+)
+
+type TimeWindow string
+
+const (
+	TimeWindowDay  TimeWindow = "day"
+	TimeWindowWeek TimeWindow = "week"
+)
+
+func GetTrendingMovies(ctx context.Context, client Client, window TimeWindow, opts ...RequestOption) (SearchResults[Movie], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/trending/movie/%s", window), opts...)
+}
+
+func GetTrendingShows(ctx context.Context, client Client, window TimeWindow, opts ...RequestOption) (SearchResults[Show], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/trending/tv/%s", window), opts...)
+}
+
+func GetTrendingPeople(ctx context.Context, client Client, window TimeWindow, opts ...RequestOption) (SearchResults[Person], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/trending/person/%s", window), opts...)
+}
+
+// GetTrendingAll returns movies, shows, and people together.  Use MultiResult.Media to tell them apart.
+func GetTrendingAll(ctx context.Context, client Client, window TimeWindow, opts ...RequestOption) (SearchResults[MultiResult], error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/trending/all/%s", window), opts...)
+}
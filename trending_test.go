@@ -0,0 +1,41 @@
+package tmdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+func TestTrending(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/trending/movie/day":  `{"page":1,"results":[{"id":550,"media_type":"movie","title":"Fight Club"}],"total_pages":500,"total_results":10000}`,
+		"/3/trending/tv/week":    `{"page":1,"results":[{"id":1396,"media_type":"tv","name":"Breaking Bad"}],"total_pages":500,"total_results":10000}`,
+		"/3/trending/person/day": `{"page":1,"results":[{"id":287,"media_type":"person","name":"Brad Pitt"}],"total_pages":500,"total_results":10000}`,
+		"/3/trending/all/week":   `{"page":1,"results":[{"id":1396,"media_type":"tv","name":"Breaking Bad"},{"id":550,"media_type":"movie","title":"Fight Club"}],"total_pages":500,"total_results":10000}`,
+	})
+	ctx := context.Background()
+
+	if movies, err := tmdb.GetTrendingMovies(ctx, client, tmdb.TimeWindowDay); err != nil {
+		t.Errorf("GetTrendingMovies: %v", err)
+	} else {
+		checkField(t, "Fight Club", movies, tmdb.SearchResults[tmdb.Movie].Results, index(0), tmdb.Movie.Title)
+		checkField(t, int32(500), movies, tmdb.SearchResults[tmdb.Movie].TotalPages)
+	}
+	if shows, err := tmdb.GetTrendingShows(ctx, client, tmdb.TimeWindowWeek); err != nil {
+		t.Errorf("GetTrendingShows: %v", err)
+	} else {
+		checkField(t, "Breaking Bad", shows, tmdb.SearchResults[tmdb.Show].Results, index(0), tmdb.Show.Name)
+	}
+	if people, err := tmdb.GetTrendingPeople(ctx, client, tmdb.TimeWindowDay); err != nil {
+		t.Errorf("GetTrendingPeople: %v", err)
+	} else {
+		checkField(t, "Brad Pitt", people, tmdb.SearchResults[tmdb.Person].Results, index(0), tmdb.Person.Name)
+	}
+	if all, err := tmdb.GetTrendingAll(ctx, client, tmdb.TimeWindowWeek); err != nil {
+		t.Errorf("GetTrendingAll: %v", err)
+	} else {
+		checkField(t, tmdb.MediaTypeTv, all, tmdb.SearchResults[tmdb.MultiResult].Results, index(0), tmdb.MultiResult.MediaType)
+		checkField(t, tmdb.MediaTypeMovie, all, tmdb.SearchResults[tmdb.MultiResult].Results, index(1), tmdb.MultiResult.MediaType)
+	}
+}
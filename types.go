@@ -1,7 +1,37 @@
 package tmdb
 
-import "github.com/krelinga/go-jsonflex"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/krelinga/go-jsonflex"
+)
 
 type Object = jsonflex.Object
 type Array = jsonflex.Array
 type Number = jsonflex.Number
+
+// optional treats a missing or null field as its zero value, and passes through any other error.
+func optional[T any](value T, err error) (T, error) {
+	if errors.Is(err, ErrFieldNotFound) || errors.Is(err, ErrNullValue) {
+		var zero T
+		return zero, nil
+	}
+	return value, err
+}
+
+// asTime converts an RFC 3339 timestamp, like "2017-02-13T23:16:19.538Z".
+func asTime() jsonflex.Converter[time.Time] {
+	return func(v any) (time.Time, error) {
+		s, err := jsonflex.AsString()(v)
+		if err != nil {
+			return time.Time{}, err
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w %q to time: %w", ErrCannotConvert, s, err)
+		}
+		return t, nil
+	}
+}
@@ -0,0 +1,198 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/krelinga/go-jsonflex"
+)
+
+type VideoSite string
+
+const (
+	VideoSiteYouTube VideoSite = "YouTube"
+	VideoSiteVimeo   VideoSite = "Vimeo"
+)
+
+type VideoType string
+
+const (
+	VideoTypeTrailer         VideoType = "Trailer"
+	VideoTypeTeaser          VideoType = "Teaser"
+	VideoTypeClip            VideoType = "Clip"
+	VideoTypeFeaturette      VideoType = "Featurette"
+	VideoTypeBehindTheScenes VideoType = "Behind the Scenes"
+	VideoTypeBloopers        VideoType = "Bloopers"
+)
+
+type Video jsonflex.Object
+
+func (v Video) ID() (string, error) {
+	return jsonflex.GetField(v, "id", jsonflex.AsString())
+}
+
+func (v Video) ISO639_1() (string, error) {
+	return jsonflex.GetField(v, "iso_639_1", jsonflex.AsString())
+}
+
+func (v Video) ISO3166_1() (string, error) {
+	return jsonflex.GetField(v, "iso_3166_1", jsonflex.AsString())
+}
+
+func (v Video) Key() (string, error) {
+	return jsonflex.GetField(v, "key", jsonflex.AsString())
+}
+
+func (v Video) Name() (string, error) {
+	return jsonflex.GetField(v, "name", jsonflex.AsString())
+}
+
+func (v Video) Official() (bool, error) {
+	return jsonflex.GetField(v, "official", jsonflex.AsBool())
+}
+
+func (v Video) PublishedAt() (string, error) {
+	return jsonflex.GetField(v, "published_at", jsonflex.AsString())
+}
+
+func (v Video) Site() (VideoSite, error) {
+	site, err := jsonflex.GetField(v, "site", jsonflex.AsString())
+	return VideoSite(site), err
+}
+
+// Size is the vertical resolution of the video, like 1080.
+func (v Video) Size() (int32, error) {
+	return jsonflex.GetField(v, "size", jsonflex.AsInt32())
+}
+
+func (v Video) Type() (VideoType, error) {
+	videoType, err := jsonflex.GetField(v, "type", jsonflex.AsString())
+	return VideoType(videoType), err
+}
+
+// URL returns the address where the video can be watched.
+func (v Video) URL() (string, error) {
+	site, err := v.Site()
+	if err != nil {
+		return "", err
+	}
+	key, err := v.Key()
+	if err != nil {
+		return "", err
+	}
+	switch site {
+	case VideoSiteYouTube:
+		return "https://www.youtube.com/watch?v=" + url.QueryEscape(key), nil
+	case VideoSiteVimeo:
+		return "https://vimeo.com/" + url.PathEscape(key), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedVideoSite, site)
+	}
+}
+
+type Videos jsonflex.Object
+
+func (v Videos) ID() (int32, error) {
+	return jsonflex.GetField(v, "id", jsonflex.AsInt32())
+}
+
+func (v Videos) Results() ([]Video, error) {
+	return jsonflex.GetField(v, "results", jsonflex.AsArray(jsonflex.AsObject[Video]()))
+}
+
+type rankedVideo struct {
+	video       Video
+	size        int32
+	publishedAt string
+}
+
+// BestTrailer picks the official trailer to show for an ISO 639-1 language, or false if there is none.  Only trailers on
+// supported sites are considered.  Higher resolution wins, then the most recently published.  If language is empty, any
+// language is acceptable.
+func (v Videos) BestTrailer(language string) (Video, bool, error) {
+	results, err := v.Results()
+	if err != nil {
+		return nil, false, err
+	}
+	var ranked []rankedVideo
+	for _, video := range results {
+		r, ok, err := rankTrailer(video, language)
+		if err != nil {
+			return nil, false, err
+		}
+		if ok {
+			ranked = append(ranked, r)
+		}
+	}
+	if len(ranked) == 0 {
+		return nil, false, nil
+	}
+	best := slices.MinFunc(ranked, func(a, b rankedVideo) int {
+		switch {
+		case a.size != b.size:
+			return int(b.size - a.size)
+		case a.publishedAt > b.publishedAt:
+			return -1
+		case a.publishedAt < b.publishedAt:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return best.video, true, nil
+}
+
+// rankTrailer reports whether video is an official trailer in language on a supported site, and how to rank it.
+func rankTrailer(video Video, language string) (rankedVideo, bool, error) {
+	r := rankedVideo{video: video}
+	videoType, err := optional(video.Type())
+	if err != nil {
+		return r, false, err
+	}
+	site, err := optional(video.Site())
+	if err != nil {
+		return r, false, err
+	}
+	official, err := optional(video.Official())
+	if err != nil {
+		return r, false, err
+	}
+	if videoType != VideoTypeTrailer || !official || (site != VideoSiteYouTube && site != VideoSiteVimeo) {
+		return r, false, nil
+	}
+	if language != "" {
+		videoLanguage, err := optional(video.ISO639_1())
+		if err != nil {
+			return r, false, err
+		}
+		if videoLanguage != language {
+			return r, false, nil
+		}
+	}
+	if r.size, err = optional(video.Size()); err != nil {
+		return r, false, err
+	}
+	// Timestamps share a fixed ISO 8601 format, so they compare correctly as strings.
+	if r.publishedAt, err = optional(video.PublishedAt()); err != nil {
+		return r, false, err
+	}
+	return r, true, nil
+}
+
+func GetMovieVideos(ctx context.Context, client Client, movieID int32, opts ...RequestOption) (Videos, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d/videos", movieID), opts...)
+}
+
+func GetShowVideos(ctx context.Context, client Client, showID int32, opts ...RequestOption) (Videos, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/videos", showID), opts...)
+}
+
+func GetSeasonVideos(ctx context.Context, client Client, showID, seasonNumber int32, opts ...RequestOption) (Videos, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d/videos", showID, seasonNumber), opts...)
+}
+
+func GetEpisodeVideos(ctx context.Context, client Client, showID, seasonNumber, episodeNumber int32, opts ...RequestOption) (Videos, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/season/%d/episode/%d/videos", showID, seasonNumber, episodeNumber), opts...)
+}
@@ -0,0 +1,120 @@
+package tmdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+const fightClubVideosJSON = `{"id":550,"results":[
+	{"iso_639_1":"en","iso_3166_1":"US","name":"Fan Trailer","key":"fan","site":"YouTube","size":2160,"type":"Trailer","official":false,"published_at":"2022-01-01T00:00:00.000Z","id":"v1"},
+	{"iso_639_1":"en","iso_3166_1":"US","name":"Official Trailer","key":"SUXWAEX2jlg","site":"YouTube","size":1080,"type":"Trailer","official":true,"published_at":"2014-10-02T19:20:22.000Z","id":"v2"},
+	{"iso_639_1":"en","iso_3166_1":"US","name":"Remastered Trailer","key":"123456","site":"Vimeo","size":1080,"type":"Trailer","official":true,"published_at":"2019-10-02T19:20:22.000Z","id":"v3"},
+	{"iso_639_1":"en","iso_3166_1":"US","name":"Featurette","key":"feat","site":"YouTube","size":2160,"type":"Featurette","official":true,"published_at":"2023-01-01T00:00:00.000Z","id":"v4"},
+	{"iso_639_1":"de","iso_3166_1":"DE","name":"Offizieller Teaser","key":"teaser","site":"YouTube","size":720,"type":"Teaser","official":true,"published_at":"2014-10-02T19:20:22.000Z","id":"v5"},
+	{"iso_639_1":"fr","iso_3166_1":"FR","name":"Bande-annonce","key":"dm","site":"Dailymotion","size":1080,"type":"Trailer","official":true,"published_at":"2014-10-02T19:20:22.000Z","id":"v6"}
+]}`
+
+func TestGetVideos(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/550/videos":                  fightClubVideosJSON,
+		"/3/tv/1399/videos":                    `{"id":1399,"results":[{"key":"got","site":"YouTube","type":"Trailer"}]}`,
+		"/3/tv/1399/season/1/videos":           `{"id":3624,"results":[{"key":"s1","site":"YouTube","type":"Teaser"}]}`,
+		"/3/tv/1399/season/1/episode/1/videos": `{"id":63056,"results":[{"key":"e1","site":"YouTube","type":"Clip"}]}`,
+	})
+	ctx := context.Background()
+
+	if videos, err := tmdb.GetMovieVideos(ctx, client, 550); err != nil {
+		t.Errorf("GetMovieVideos: %v", err)
+	} else {
+		checkField(t, int32(550), videos, tmdb.Videos.ID)
+		checkField(t, "SUXWAEX2jlg", videos, tmdb.Videos.Results, index(1), tmdb.Video.Key)
+		checkField(t, tmdb.VideoSiteYouTube, videos, tmdb.Videos.Results, index(1), tmdb.Video.Site)
+		checkField(t, tmdb.VideoTypeTrailer, videos, tmdb.Videos.Results, index(1), tmdb.Video.Type)
+		checkField(t, true, videos, tmdb.Videos.Results, index(1), tmdb.Video.Official)
+		checkField(t, int32(1080), videos, tmdb.Videos.Results, index(1), tmdb.Video.Size)
+		checkField(t, "US", videos, tmdb.Videos.Results, index(1), tmdb.Video.ISO3166_1)
+	}
+	if videos, err := tmdb.GetShowVideos(ctx, client, 1399); err != nil {
+		t.Errorf("GetShowVideos: %v", err)
+	} else {
+		checkField(t, "got", videos, tmdb.Videos.Results, index(0), tmdb.Video.Key)
+	}
+	if videos, err := tmdb.GetSeasonVideos(ctx, client, 1399, 1); err != nil {
+		t.Errorf("GetSeasonVideos: %v", err)
+	} else {
+		checkField(t, tmdb.VideoTypeTeaser, videos, tmdb.Videos.Results, index(0), tmdb.Video.Type)
+	}
+	if videos, err := tmdb.GetEpisodeVideos(ctx, client, 1399, 1, 1); err != nil {
+		t.Errorf("GetEpisodeVideos: %v", err)
+	} else {
+		checkField(t, tmdb.VideoTypeClip, videos, tmdb.Videos.Results, index(0), tmdb.Video.Type)
+	}
+}
+
+func TestVideoURL(t *testing.T) {
+	tests := []struct {
+		video string
+		want  string
+	}{
+		{`{"site":"YouTube","key":"SUXWAEX2jlg"}`, "https://www.youtube.com/watch?v=SUXWAEX2jlg"},
+		{`{"site":"Vimeo","key":"123456"}`, "https://vimeo.com/123456"},
+	}
+	for _, tt := range tests {
+		var video tmdb.Video
+		if err := json.Unmarshal([]byte(tt.video), &video); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+		checkField(t, tt.want, video, tmdb.Video.URL)
+	}
+	if _, err := (tmdb.Video{"site": "Dailymotion", "key": "x"}).URL(); !errors.Is(err, tmdb.ErrUnsupportedVideoSite) {
+		t.Errorf("expected ErrUnsupportedVideoSite, got %v", err)
+	}
+}
+
+func TestBestTrailer(t *testing.T) {
+	var videos tmdb.Videos
+	if err := json.Unmarshal([]byte(fightClubVideosJSON), &videos); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	tests := []struct {
+		language string
+		want     string
+	}{
+		// The fan trailer is unofficial despite its resolution, and the newer of two equal official trailers wins.
+		{"en", "v3"},
+		{"", "v3"},
+	}
+	for _, tt := range tests {
+		best, ok, err := videos.BestTrailer(tt.language)
+		if err != nil || !ok {
+			t.Errorf("BestTrailer(%q): %v, %v", tt.language, ok, err)
+			continue
+		}
+		checkField(t, tt.want, best, tmdb.Video.ID)
+	}
+
+	// German only has a teaser, and the French trailer is on an unsupported site, so neither has a trailer.  Nor does a
+	// language with no videos at all.
+	for _, language := range []string{"de", "fr", "ja"} {
+		if best, ok, err := videos.BestTrailer(language); ok || err != nil {
+			t.Errorf("BestTrailer(%q): expected no trailer, got %v, %v, %v", language, best, ok, err)
+		}
+	}
+	if _, ok, err := (tmdb.Videos{"results": []any{}}).BestTrailer("en"); ok || err != nil {
+		t.Errorf("expected no trailer, got %v, %v", ok, err)
+	}
+}
+
+func TestIncludeVideoLanguage(t *testing.T) {
+	client, query, _ := newQueryRecordingClient(t, `{"id":550,"results":[]}`)
+	if _, err := tmdb.GetMovieVideos(context.Background(), client, 550, tmdb.WithIncludeVideoLanguage("en", "null")); err != nil {
+		t.Fatalf("GetMovieVideos: %v", err)
+	}
+	if got := query().Get("include_video_language"); got != "en,null" {
+		t.Errorf("expected include_video_language=en,null, got %q", got)
+	}
+}
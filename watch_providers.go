@@ -0,0 +1,143 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krelinga/go-jsonflex"
+)
+
+type WatchProvider Object
+
+func (w WatchProvider) DisplayPriority() (int32, error) {
+	return jsonflex.GetField(w, "display_priority", jsonflex.AsInt32())
+}
+
+func (w WatchProvider) LogoPath() (string, error) {
+	return jsonflex.GetField(w, "logo_path", jsonflex.AsString())
+}
+
+func (w WatchProvider) ProviderID() (int32, error) {
+	return jsonflex.GetField(w, "provider_id", jsonflex.AsInt32())
+}
+
+func (w WatchProvider) ProviderName() (string, error) {
+	return jsonflex.GetField(w, "provider_name", jsonflex.AsString())
+}
+
+// DisplayPriorities maps ISO 3166-1 region codes to the provider's display priority there.  It is only present in the
+// provider catalogs.
+func (w WatchProvider) DisplayPriorities() (map[string]int32, error) {
+	priorities, err := jsonflex.GetField(w, "display_priorities", jsonflex.AsObject[Object]())
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]int32, len(priorities))
+	for region := range priorities {
+		if result[region], err = jsonflex.GetField(priorities, region, jsonflex.AsInt32()); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (w WatchProvider) LogoURL(b *ImageURLBuilder, size string) (string, error) {
+	path, err := w.LogoPath()
+	if err != nil {
+		return "", err
+	}
+	return b.URL(ImageKindLogo, size, path)
+}
+
+// WatchProviderRegion lists the ways to watch a movie or show in one region.
+type WatchProviderRegion Object
+
+// Link is TMDB's page for the region, which links out to each provider.  TMDB's data comes from JustWatch.
+func (w WatchProviderRegion) Link() (string, error) {
+	return jsonflex.GetField(w, "link", jsonflex.AsString())
+}
+
+func (w WatchProviderRegion) Ads() ([]WatchProvider, error) {
+	return jsonflex.GetField(w, "ads", jsonflex.AsArray(jsonflex.AsObject[WatchProvider]()))
+}
+
+func (w WatchProviderRegion) Buy() ([]WatchProvider, error) {
+	return jsonflex.GetField(w, "buy", jsonflex.AsArray(jsonflex.AsObject[WatchProvider]()))
+}
+
+func (w WatchProviderRegion) Flatrate() ([]WatchProvider, error) {
+	return jsonflex.GetField(w, "flatrate", jsonflex.AsArray(jsonflex.AsObject[WatchProvider]()))
+}
+
+func (w WatchProviderRegion) Free() ([]WatchProvider, error) {
+	return jsonflex.GetField(w, "free", jsonflex.AsArray(jsonflex.AsObject[WatchProvider]()))
+}
+
+func (w WatchProviderRegion) Rent() ([]WatchProvider, error) {
+	return jsonflex.GetField(w, "rent", jsonflex.AsArray(jsonflex.AsObject[WatchProvider]()))
+}
+
+type WatchProviders Object
+
+func (w WatchProviders) ID() (int32, error) {
+	return jsonflex.GetField(w, "id", jsonflex.AsInt32())
+}
+
+// Results maps ISO 3166-1 region codes to the ways to watch in that region.
+func (w WatchProviders) Results() (map[string]WatchProviderRegion, error) {
+	regions, err := jsonflex.GetField(w, "results", jsonflex.AsObject[Object]())
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]WatchProviderRegion, len(regions))
+	for region := range regions {
+		if result[region], err = jsonflex.GetField(regions, region, jsonflex.AsObject[WatchProviderRegion]()); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Region returns the ways to watch in an ISO 3166-1 region, or ErrFieldNotFound if there are none.
+func (w WatchProviders) Region(region string) (WatchProviderRegion, error) {
+	regions, err := jsonflex.GetField(w, "results", jsonflex.AsObject[Object]())
+	if err != nil {
+		return nil, err
+	}
+	return jsonflex.GetField(regions, region, jsonflex.AsObject[WatchProviderRegion]())
+}
+
+func GetMovieWatchProviders(ctx context.Context, client Client, movieID int32, opts ...RequestOption) (WatchProviders, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/movie/%d/watch/providers", movieID), opts...)
+}
+
+func GetShowWatchProviders(ctx context.Context, client Client, showID int32, opts ...RequestOption) (WatchProviders, error) {
+	return client.GetObject(ctx, fmt.Sprintf("/3/tv/%d/watch/providers", showID), opts...)
+}
+
+// GetMovieWatchProviderCatalog lists every provider that has movies.  Use WithRegion to limit it to one region.
+func GetMovieWatchProviderCatalog(ctx context.Context, client Client, opts ...RequestOption) ([]WatchProvider, error) {
+	catalog, err := client.GetObject(ctx, "/3/watch/providers/movie", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return jsonflex.GetField(catalog, "results", jsonflex.AsArray(jsonflex.AsObject[WatchProvider]()))
+}
+
+// GetShowWatchProviderCatalog lists every provider that has shows.  Use WithRegion to limit it to one region.
+func GetShowWatchProviderCatalog(ctx context.Context, client Client, opts ...RequestOption) ([]WatchProvider, error) {
+	catalog, err := client.GetObject(ctx, "/3/watch/providers/tv", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return jsonflex.GetField(catalog, "results", jsonflex.AsArray(jsonflex.AsObject[WatchProvider]()))
+}
+
+// GetWatchProviderRegions lists the regions that TMDB has watch provider data for.
+func GetWatchProviderRegions(ctx context.Context, client Client, opts ...RequestOption) ([]Country, error) {
+	regions, err := client.GetObject(ctx, "/3/watch/providers/regions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return jsonflex.GetField(regions, "results", jsonflex.AsArray(jsonflex.AsObject[Country]()))
+}
@@ -0,0 +1,107 @@
+package tmdb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/krelinga/go-tmdb"
+)
+
+const netflixJSON = `{"logo_path":"/pbpMk2JmcoNnQwx5JGpXngfoWtp.jpg","provider_id":8,"provider_name":"Netflix","display_priority":4}`
+
+func TestGetWatchProviders(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/movie/550/watch/providers": `{"id":550,"results":{
+			"US":{"link":"https://www.themoviedb.org/movie/550-fight-club/watch?locale=US","flatrate":[{"logo_path":"/hulu.jpg","provider_id":15,"provider_name":"Hulu","display_priority":5}],"rent":[{"logo_path":"/apple.jpg","provider_id":2,"provider_name":"Apple TV","display_priority":6}],"buy":[{"logo_path":"/apple.jpg","provider_id":2,"provider_name":"Apple TV","display_priority":6}]},
+			"BR":{"link":"https://www.themoviedb.org/movie/550-fight-club/watch?locale=BR","ads":[{"logo_path":"/pluto.jpg","provider_id":300,"provider_name":"Pluto TV","display_priority":20}],"free":[{"logo_path":"/libre.jpg","provider_id":301,"provider_name":"Libre","display_priority":30}]}
+		}}`,
+		"/3/tv/1399/watch/providers": `{"id":1399,"results":{"US":{"link":"https://www.themoviedb.org/tv/1399/watch?locale=US","flatrate":[{"logo_path":"/max.jpg","provider_id":1899,"provider_name":"Max","display_priority":3}]}}}`,
+	})
+	ctx := context.Background()
+
+	providers, err := tmdb.GetMovieWatchProviders(ctx, client, 550)
+	if err != nil {
+		t.Fatalf("GetMovieWatchProviders: %v", err)
+	}
+	checkField(t, int32(550), providers, tmdb.WatchProviders.ID)
+	results, err := providers.Results()
+	if err != nil {
+		t.Fatalf("Results: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("expected 2 regions, got %d", len(results))
+	}
+	us := results["US"]
+	checkField(t, "https://www.themoviedb.org/movie/550-fight-club/watch?locale=US", us, tmdb.WatchProviderRegion.Link)
+	checkField(t, "Hulu", us, tmdb.WatchProviderRegion.Flatrate, index(0), tmdb.WatchProvider.ProviderName)
+	checkField(t, int32(2), us, tmdb.WatchProviderRegion.Rent, index(0), tmdb.WatchProvider.ProviderID)
+	checkField(t, int32(6), us, tmdb.WatchProviderRegion.Buy, index(0), tmdb.WatchProvider.DisplayPriority)
+	if br, err := providers.Region("BR"); err != nil {
+		t.Errorf("Region(BR): %v", err)
+	} else {
+		checkField(t, "Pluto TV", br, tmdb.WatchProviderRegion.Ads, index(0), tmdb.WatchProvider.ProviderName)
+		checkField(t, "Libre", br, tmdb.WatchProviderRegion.Free, index(0), tmdb.WatchProvider.ProviderName)
+		if _, err := br.Flatrate(); !errors.Is(err, tmdb.ErrFieldNotFound) {
+			t.Errorf("expected ErrFieldNotFound for flatrate, got %v", err)
+		}
+	}
+	if _, err := providers.Region("FR"); !errors.Is(err, tmdb.ErrFieldNotFound) {
+		t.Errorf("expected ErrFieldNotFound for FR, got %v", err)
+	}
+
+	if providers, err := tmdb.GetShowWatchProviders(ctx, client, 1399); err != nil {
+		t.Errorf("GetShowWatchProviders: %v", err)
+	} else if us, err := providers.Region("US"); err != nil {
+		t.Errorf("Region(US): %v", err)
+	} else {
+		checkField(t, "Max", us, tmdb.WatchProviderRegion.Flatrate, index(0), tmdb.WatchProvider.ProviderName)
+	}
+}
+
+func TestGetWatchProviderCatalogs(t *testing.T) {
+	client := newFakeClient(t, map[string]string{
+		"/3/watch/providers/movie":   `{"results":[{"display_priorities":{"US":4,"BR":2},"logo_path":"/pbpMk2JmcoNnQwx5JGpXngfoWtp.jpg","provider_id":8,"provider_name":"Netflix","display_priority":4}]}`,
+		"/3/watch/providers/tv":      `{"results":[` + netflixJSON + `]}`,
+		"/3/watch/providers/regions": `{"results":[{"iso_3166_1":"BR","english_name":"Brazil","native_name":"Brasil"}]}`,
+	})
+	ctx := context.Background()
+
+	if catalog, err := tmdb.GetMovieWatchProviderCatalog(ctx, client); err != nil {
+		t.Errorf("GetMovieWatchProviderCatalog: %v", err)
+	} else if len(catalog) != 1 {
+		t.Errorf("expected 1 provider, got %d", len(catalog))
+	} else {
+		checkField(t, "Netflix", catalog[0], tmdb.WatchProvider.ProviderName)
+		if priorities, err := catalog[0].DisplayPriorities(); err != nil || priorities["BR"] != 2 || priorities["US"] != 4 {
+			t.Errorf("unexpected display priorities %v, %v", priorities, err)
+		}
+	}
+	if catalog, err := tmdb.GetShowWatchProviderCatalog(ctx, client); err != nil {
+		t.Errorf("GetShowWatchProviderCatalog: %v", err)
+	} else if len(catalog) != 1 {
+		t.Errorf("expected 1 provider, got %d", len(catalog))
+	} else {
+		checkField(t, int32(8), catalog[0], tmdb.WatchProvider.ProviderID)
+	}
+	if regions, err := tmdb.GetWatchProviderRegions(ctx, client); err != nil {
+		t.Errorf("GetWatchProviderRegions: %v", err)
+	} else if len(regions) != 1 {
+		t.Errorf("expected 1 region, got %d", len(regions))
+	} else {
+		checkField(t, "BR", regions[0], tmdb.Country.ISO3166_1)
+		checkField(t, "Brasil", regions[0], tmdb.Country.NativeName)
+	}
+}
+
+func TestWatchProviderLogoURL(t *testing.T) {
+	client := newFakeClient(t, map[string]string{"/3/watch/providers/tv": `{"results":[` + netflixJSON + `]}`})
+	catalog, err := tmdb.GetShowWatchProviderCatalog(context.Background(), client)
+	if err != nil {
+		t.Fatalf("GetShowWatchProviderCatalog: %v", err)
+	}
+	b := newTestImageURLBuilder(t)
+	checkField(t, "https://image.tmdb.org/t/p/w92/pbpMk2JmcoNnQwx5JGpXngfoWtp.jpg", catalog[0], func(w tmdb.WatchProvider) (string, error) {
+		return w.LogoURL(b, "w92")
+	})
+}